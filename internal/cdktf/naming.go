@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cdktf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Language describes a CDK for Terraform (CDKTF) target language.
+type Language struct {
+	// Name is the CDKTF language name, as used in documentation directories
+	// (e.g. "typescript").
+	Name string
+
+	// FileExtension is the extension of example files written in this
+	// language, including the leading period (e.g. ".ts").
+	FileExtension string
+
+	// convertName converts a Terraform (snake case) name to the naming
+	// convention of this language.
+	convertName func(string) string
+}
+
+// ConvertName converts a Terraform (snake case) attribute or block name to
+// the naming convention of the language.
+func (l Language) ConvertName(name string) string {
+	return l.convertName(name)
+}
+
+// ConvertPath converts a Terraform attribute or block path (e.g.
+// "root_volume.volume_size") to the naming convention of the language.
+func (l Language) ConvertPath(path string) string {
+	names := strings.Split(path, ".")
+
+	for i, name := range names {
+		names[i] = l.ConvertName(name)
+	}
+
+	return strings.Join(names, ".")
+}
+
+var languages = map[string]Language{
+	"csharp": {
+		Name:          "csharp",
+		FileExtension: ".cs",
+		convertName:   pascalCase,
+	},
+	"go": {
+		Name:          "go",
+		FileExtension: ".go",
+		convertName:   pascalCase,
+	},
+	"java": {
+		Name:          "java",
+		FileExtension: ".java",
+		convertName:   camelCase,
+	},
+	"python": {
+		Name:          "python",
+		FileExtension: ".py",
+		convertName:   snakeCase,
+	},
+	"typescript": {
+		Name:          "typescript",
+		FileExtension: ".ts",
+		convertName:   camelCase,
+	},
+}
+
+// LanguageNames returns the sorted names of all supported CDKTF languages.
+func LanguageNames() []string {
+	names := make([]string, 0, len(languages))
+
+	for name := range languages {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// LookupLanguage returns the CDKTF language with the given name.
+func LookupLanguage(name string) (Language, error) {
+	language, ok := languages[name]
+	if !ok {
+		return Language{}, fmt.Errorf("unsupported CDKTF language %q, supported languages: %s", name, strings.Join(LanguageNames(), ", "))
+	}
+
+	return language, nil
+}
+
+// ConvertSchema returns a copy of the given schema with all attribute and
+// block names converted to the naming convention of the language. The given
+// schema is not modified.
+func (l Language) ConvertSchema(schema *tfjson.Schema) *tfjson.Schema {
+	if schema == nil {
+		return nil
+	}
+
+	return &tfjson.Schema{
+		Version: schema.Version,
+		Block:   l.convertBlock(schema.Block),
+	}
+}
+
+func (l Language) convertBlock(block *tfjson.SchemaBlock) *tfjson.SchemaBlock {
+	if block == nil {
+		return nil
+	}
+
+	converted := &tfjson.SchemaBlock{
+		Description:     block.Description,
+		DescriptionKind: block.DescriptionKind,
+		Deprecated:      block.Deprecated,
+	}
+
+	if block.Attributes != nil {
+		converted.Attributes = l.convertAttributes(block.Attributes)
+	}
+
+	if block.NestedBlocks != nil {
+		converted.NestedBlocks = make(map[string]*tfjson.SchemaBlockType, len(block.NestedBlocks))
+
+		for name, blockType := range block.NestedBlocks {
+			convertedBlockType := *blockType
+			convertedBlockType.Block = l.convertBlock(blockType.Block)
+			converted.NestedBlocks[l.ConvertName(name)] = &convertedBlockType
+		}
+	}
+
+	return converted
+}
+
+func (l Language) convertAttributes(attributes map[string]*tfjson.SchemaAttribute) map[string]*tfjson.SchemaAttribute {
+	converted := make(map[string]*tfjson.SchemaAttribute, len(attributes))
+
+	for name, attribute := range attributes {
+		convertedAttribute := *attribute
+
+		if attribute.AttributeNestedType != nil {
+			nestedType := *attribute.AttributeNestedType
+			nestedType.Attributes = l.convertAttributes(attribute.AttributeNestedType.Attributes)
+			convertedAttribute.AttributeNestedType = &nestedType
+		} else if attribute.AttributeType != cty.NilType {
+			convertedAttribute.AttributeType = l.convertType(attribute.AttributeType)
+		}
+
+		converted[l.ConvertName(name)] = &convertedAttribute
+	}
+
+	return converted
+}
+
+func (l Language) convertType(ty cty.Type) cty.Type {
+	switch {
+	case ty.IsObjectType():
+		attributeTypes := make(map[string]cty.Type, len(ty.AttributeTypes()))
+		var optional []string
+
+		for name, attributeType := range ty.AttributeTypes() {
+			attributeTypes[l.ConvertName(name)] = l.convertType(attributeType)
+
+			if ty.AttributeOptional(name) {
+				optional = append(optional, l.ConvertName(name))
+			}
+		}
+
+		if len(optional) > 0 {
+			return cty.ObjectWithOptionalAttrs(attributeTypes, optional)
+		}
+
+		return cty.Object(attributeTypes)
+	case ty.IsListType():
+		return cty.List(l.convertType(ty.ElementType()))
+	case ty.IsSetType():
+		return cty.Set(l.convertType(ty.ElementType()))
+	case ty.IsMapType():
+		return cty.Map(l.convertType(ty.ElementType()))
+	case ty.IsTupleType():
+		elementTypes := make([]cty.Type, 0, len(ty.TupleElementTypes()))
+
+		for _, elementType := range ty.TupleElementTypes() {
+			elementTypes = append(elementTypes, l.convertType(elementType))
+		}
+
+		return cty.Tuple(elementTypes)
+	}
+
+	return ty
+}
+
+func snakeCase(name string) string {
+	return name
+}
+
+func camelCase(name string) string {
+	parts := strings.Split(name, "_")
+
+	for i := 1; i < len(parts); i++ {
+		parts[i] = upperFirst(parts[i])
+	}
+
+	return strings.Join(parts, "")
+}
+
+func pascalCase(name string) string {
+	parts := strings.Split(name, "_")
+
+	for i := range parts {
+		parts[i] = upperFirst(parts[i])
+	}
+
+	return strings.Join(parts, "")
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
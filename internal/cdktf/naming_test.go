@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cdktf_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/cdktf"
+)
+
+func TestLanguage_ConvertName(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		language string
+		name     string
+		expected string
+	}{
+		{"csharp", "instance_type", "InstanceType"},
+		{"go", "instance_type", "InstanceType"},
+		{"java", "instance_type", "instanceType"},
+		{"python", "instance_type", "instance_type"},
+		{"typescript", "instance_type", "instanceType"},
+		{"typescript", "id", "id"},
+		{"go", "id", "Id"},
+	} {
+		t.Run(c.language+"/"+c.name, func(t *testing.T) {
+			t.Parallel()
+
+			language, err := cdktf.LookupLanguage(c.language)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := language.ConvertName(c.name)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLanguage_ConvertPath(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		language string
+		path     string
+		expected string
+	}{
+		{"go", "root_volume.volume_size", "RootVolume.VolumeSize"},
+		{"python", "root_volume.volume_size", "root_volume.volume_size"},
+		{"typescript", "root_volume.volume_size", "rootVolume.volumeSize"},
+		{"typescript", "instance_type", "instanceType"},
+		{"typescript", "network_configs.0.subnet_id", "networkConfigs.0.subnetId"},
+	} {
+		t.Run(c.language+"/"+c.path, func(t *testing.T) {
+			t.Parallel()
+
+			language, err := cdktf.LookupLanguage(c.language)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := language.ConvertPath(c.path)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLookupLanguage_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := cdktf.LookupLanguage("rust")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := `unsupported CDKTF language "rust", supported languages: csharp, go, java, python, typescript`
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestLanguage_ConvertSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"instance_type": {
+					AttributeType: cty.String,
+					Required:      true,
+				},
+				"network_config": {
+					AttributeType: cty.List(cty.Object(map[string]cty.Type{
+						"subnet_id": cty.String,
+					})),
+					Optional: true,
+				},
+				"root_volume": {
+					AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeSingle,
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"volume_size": {
+								AttributeType: cty.Number,
+								Optional:      true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"ebs_block_device": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"device_name": {
+								AttributeType: cty.String,
+								Required:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"instanceType": {
+					AttributeType: cty.String,
+					Required:      true,
+				},
+				"networkConfig": {
+					AttributeType: cty.List(cty.Object(map[string]cty.Type{
+						"subnetId": cty.String,
+					})),
+					Optional: true,
+				},
+				"rootVolume": {
+					AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeSingle,
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"volumeSize": {
+								AttributeType: cty.Number,
+								Optional:      true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"ebsBlockDevice": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"deviceName": {
+								AttributeType: cty.String,
+								Required:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	language, err := cdktf.LookupLanguage("typescript")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := language.ConvertSchema(schema)
+
+	if diff := cmp.Diff(expected, actual, cmp.Comparer(func(x, y cty.Type) bool { return x.Equals(y) })); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+	if _, ok := schema.Block.Attributes["instance_type"]; !ok {
+		t.Fatal("expected original schema to be unmodified")
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+type CdktfFileMismatchOptions struct {
+	*FileOptions
+
+	// DocumentationEntries are the HCL documentation files, keyed by
+	// documentation subdirectory (e.g. "resources").
+	DocumentationEntries map[string][]os.DirEntry
+
+	// LanguageEntries are the CDKTF documentation files, keyed by language
+	// (e.g. "typescript") and then by documentation subdirectory.
+	LanguageEntries map[string]map[string][]os.DirEntry
+}
+
+type CdktfFileMismatchCheck struct {
+	Options *CdktfFileMismatchOptions
+}
+
+func NewCdktfFileMismatchCheck(opts *CdktfFileMismatchOptions) *CdktfFileMismatchCheck {
+	check := &CdktfFileMismatchCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &CdktfFileMismatchOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run checks that each CDKTF language contains a documentation file for
+// every HCL documentation file in the same subdirectory, and no others.
+func (check *CdktfFileMismatchCheck) Run() error {
+	var result error
+
+	if len(check.Options.LanguageEntries) == 0 {
+		log.Printf("[DEBUG] Skipping CDKTF file mismatch checks due to missing CDKTF documentation")
+		return nil
+	}
+
+	languages := make([]string, 0, len(check.Options.LanguageEntries))
+	for language := range check.Options.LanguageEntries {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	subdirectorySet := make(map[string]struct{})
+	for subdirectory := range check.Options.DocumentationEntries {
+		subdirectorySet[subdirectory] = struct{}{}
+	}
+	for _, languageEntries := range check.Options.LanguageEntries {
+		for subdirectory := range languageEntries {
+			subdirectorySet[subdirectory] = struct{}{}
+		}
+	}
+
+	subdirectories := make([]string, 0, len(subdirectorySet))
+	for subdirectory := range subdirectorySet {
+		subdirectories = append(subdirectories, subdirectory)
+	}
+	sort.Strings(subdirectories)
+
+	for _, language := range languages {
+		for _, subdirectory := range subdirectories {
+			err := check.LanguageFileMismatchCheck(language, subdirectory, check.Options.DocumentationEntries[subdirectory], check.Options.LanguageEntries[language][subdirectory])
+			result = errors.Join(result, err)
+		}
+	}
+
+	return result
+}
+
+// LanguageFileMismatchCheck checks for mismatched files, either missing or extraneous, in the given CDKTF language subdirectory against the HCL documentation files
+func (check *CdktfFileMismatchCheck) LanguageFileMismatchCheck(language, subdirectory string, files []os.DirEntry, languageFiles []os.DirEntry) error {
+	var result error
+
+	for _, file := range languageFiles {
+		if file.IsDir() {
+			continue
+		}
+
+		if languageHasFile(files, TrimFileExtension(file.Name())) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Found extraneous CDKTF %s file %s/%s", language, subdirectory, file.Name())
+		err := fmt.Errorf("matching HCL documentation file for CDKTF %s documentation file (%s/%s) not found, file is extraneous or incorrectly named", language, subdirectory, file.Name())
+		result = errors.Join(result, err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		name := TrimFileExtension(file.Name())
+
+		if languageHasFile(languageFiles, name) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Missing CDKTF %s file for %s/%s", language, subdirectory, file.Name())
+		err := fmt.Errorf("missing CDKTF %s documentation file for %s: %s", language, subdirectory, name)
+		result = errors.Join(result, err)
+	}
+
+	return result
+}
+
+func languageHasFile(files []os.DirEntry, name string) bool {
+	for _, file := range files {
+		if TrimFileExtension(file.Name()) == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCdktfFileMismatchCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ResourceFiles           fstest.MapFS
+		DataSourceFiles         fstest.MapFS
+		TypescriptResourceFiles fstest.MapFS
+		PythonResourceFiles     fstest.MapFS
+		ExpectedError           string
+	}{
+		"all found": {
+			ResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+				"resource2.md": {},
+			},
+			TypescriptResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+				"resource2.md": {},
+			},
+			PythonResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+				"resource2.md": {},
+			},
+		},
+		"all found - different extensions": {
+			ResourceFiles: fstest.MapFS{
+				"resource1.html.markdown": {},
+			},
+			TypescriptResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+			},
+			PythonResourceFiles: fstest.MapFS{
+				"resource1.html.md": {},
+			},
+		},
+		"extra language file": {
+			ResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+			},
+			TypescriptResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+				"resource2.md": {},
+			},
+			PythonResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+			},
+			ExpectedError: "matching HCL documentation file for CDKTF typescript documentation file (resources/resource2.md) not found, file is extraneous or incorrectly named",
+		},
+		"missing language files": {
+			ResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+				"resource2.md": {},
+			},
+			DataSourceFiles: fstest.MapFS{
+				"datasource1.md": {},
+			},
+			TypescriptResourceFiles: fstest.MapFS{
+				"resource1.md": {},
+			},
+			PythonResourceFiles: fstest.MapFS{
+				"resource2.md": {},
+			},
+			ExpectedError: "missing CDKTF python documentation file for data-sources: datasource1\n" +
+				"missing CDKTF python documentation file for resources: resource1\n" +
+				"missing CDKTF typescript documentation file for data-sources: datasource1\n" +
+				"missing CDKTF typescript documentation file for resources: resource2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceFiles, _ := testCase.ResourceFiles.ReadDir(".")
+			dataSourceFiles, _ := testCase.DataSourceFiles.ReadDir(".")
+			typescriptResourceFiles, _ := testCase.TypescriptResourceFiles.ReadDir(".")
+			pythonResourceFiles, _ := testCase.PythonResourceFiles.ReadDir(".")
+
+			got := NewCdktfFileMismatchCheck(&CdktfFileMismatchOptions{
+				DocumentationEntries: map[string][]os.DirEntry{
+					"data-sources": dataSourceFiles,
+					"resources":    resourceFiles,
+				},
+				LanguageEntries: map[string]map[string][]os.DirEntry{
+					"python": {
+						"resources": pythonResourceFiles,
+					},
+					"typescript": {
+						"resources": typescriptResourceFiles,
+					},
+				},
+			}).Run()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
@@ -72,6 +72,23 @@ var ValidCdktfLanguages = []string{
 	"typescript",
 }
 
+// CdktfRegistrySubdirectories are the subdirectories of each CDKTF language
+// directory (e.g. "docs/cdktf/typescript") which are generated from the
+// provider schema and checked against the HCL documentation.
+var CdktfRegistrySubdirectories = []string{
+	RegistryDataSourcesDirectory,
+	RegistryEphemeralResourcesDirectory,
+	RegistryResourcesDirectory,
+}
+
+// CdktfLegacySubdirectories are the legacy layout equivalents of
+// CdktfRegistrySubdirectories.
+var CdktfLegacySubdirectories = []string{
+	LegacyDataSourcesDirectory,
+	LegacyEphemeralResourcesDirectory,
+	LegacyResourcesDirectory,
+}
+
 var ValidLegacySubdirectories = []string{
 	LegacyIndexDirectory,
 	LegacyDataSourcesDirectory,
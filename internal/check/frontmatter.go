@@ -6,7 +6,9 @@ package check
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"slices"
+	"sort"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/parser"
@@ -26,9 +28,18 @@ type FrontMatterData struct {
 	Subcategory    *string `yaml:"subcategory,omitempty"`
 }
 
+// FrontMatterKeyOptions represents configuration options for a custom
+// FrontMatter key, such as those defined by a frontmatter schema file.
+type FrontMatterKeyOptions struct {
+	AllowedValues []string
+	Pattern       *regexp.Regexp
+	Required      bool
+}
+
 // FrontMatterOptions represents configuration options for FrontMatter.
 type FrontMatterOptions struct {
 	AllowedSubcategories []string
+	Keys                 map[string]*FrontMatterKeyOptions
 	NoLayout             bool
 	NoPageTitle          bool
 	NoSidebarCurrent     bool
@@ -108,5 +119,50 @@ func (check *FrontMatterCheck) Run(src []byte) error {
 		}
 	}
 
+	if len(check.Options.Keys) == 0 {
+		return nil
+	}
+
+	keyValues := map[string]interface{}{}
+
+	err = d.Decode(&keyValues)
+	if err != nil {
+		return fmt.Errorf("error parsing YAML frontmatter: %w", err)
+	}
+
+	keys := make([]string, 0, len(check.Options.Keys))
+	for key := range check.Options.Keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyOptions := check.Options.Keys[key]
+
+		if keyOptions == nil {
+			continue
+		}
+
+		rawValue, ok := keyValues[key]
+
+		if !ok || rawValue == nil {
+			if keyOptions.Required {
+				return fmt.Errorf("YAML frontmatter missing required %s", key)
+			}
+
+			continue
+		}
+
+		value := fmt.Sprint(rawValue)
+
+		if len(keyOptions.AllowedValues) != 0 && !slices.Contains(keyOptions.AllowedValues, value) {
+			return fmt.Errorf("YAML frontmatter contains a %s (%s) that is not in the allowed list", key, value)
+		}
+
+		if keyOptions.Pattern != nil && !keyOptions.Pattern.MatchString(value) {
+			return fmt.Errorf("YAML frontmatter contains a %s (%s) that does not match the pattern %s", key, value, keyOptions.Pattern)
+		}
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package check
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -160,6 +161,84 @@ subcategory: Example Subcategory
 			},
 			ExpectError: true,
 		},
+		"required custom key option": {
+			Source: `
+---
+owner: platform-team
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"owner": {Required: true},
+				},
+			},
+			ExpectError: false,
+		},
+		"missing required custom key option": {
+			Source: `
+---
+subcategory: Example Subcategory
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"owner": {Required: true},
+				},
+			},
+			ExpectError: true,
+		},
+		"allowed custom key value option": {
+			Source: `
+---
+stability: beta
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"stability": {AllowedValues: []string{"stable", "beta"}},
+				},
+			},
+			ExpectError: false,
+		},
+		"disallowed custom key value option": {
+			Source: `
+---
+stability: experimental
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"stability": {AllowedValues: []string{"stable", "beta"}},
+				},
+			},
+			ExpectError: true,
+		},
+		"matching custom key pattern option": {
+			Source: `
+---
+min_provider_version: 1.2.0
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"min_provider_version": {Pattern: regexp.MustCompile(`^\d+\.\d+\.\d+$`)},
+				},
+			},
+			ExpectError: false,
+		},
+		"mismatching custom key pattern option": {
+			Source: `
+---
+min_provider_version: latest
+---
+`,
+			Options: &FrontMatterOptions{
+				Keys: map[string]*FrontMatterKeyOptions{
+					"min_provider_version": {Pattern: regexp.MustCompile(`^\d+\.\d+\.\d+$`)},
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+type FunctionExampleCheck struct {
+	Options *FunctionExampleOptions
+}
+
+// FunctionExampleOptions represents configuration options for FunctionExample.
+type FunctionExampleOptions struct {
+	// FunctionName is the name of the function, without the provider
+	// namespace (e.g. "parse_rfc3339").
+	FunctionName string
+
+	// ProviderShortName is the provider namespace of the function (e.g.
+	// "time" for "provider::time::parse_rfc3339").
+	ProviderShortName string
+
+	// Signature is the function signature, used to verify the number of
+	// arguments of each call.
+	Signature *tfjson.FunctionSignature
+}
+
+func NewFunctionExampleCheck(opts *FunctionExampleOptions) *FunctionExampleCheck {
+	check := &FunctionExampleCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &FunctionExampleOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the given function example file calls the function with the
+// provider namespace and a valid number of arguments.
+func (check *FunctionExampleCheck) Run(path string, src []byte) error {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return fmt.Errorf("error parsing function example file %q: %w", path, diags)
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return fmt.Errorf("error parsing function example file %q: unexpected body type %T", path, file.Body)
+	}
+
+	expectedName := "provider::" + check.Options.ProviderShortName + "::" + check.Options.FunctionName
+
+	var result error
+	found := false
+
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		call, ok := node.(*hclsyntax.FunctionCallExpr)
+		if !ok {
+			return nil
+		}
+
+		switch {
+		case call.Name == expectedName:
+			found = true
+
+			if err := check.argumentCount(call); err != nil {
+				result = errors.Join(result, fmt.Errorf("%s: %w", call.NameRange, err))
+			}
+		case strings.HasPrefix(call.Name, "provider::") && strings.HasSuffix(call.Name, "::"+check.Options.FunctionName):
+			found = true
+
+			result = errors.Join(result, fmt.Errorf("%s: call to %q has the wrong provider namespace, expected %q", call.NameRange, call.Name, expectedName))
+		}
+
+		return nil
+	})
+
+	if !found {
+		result = errors.Join(result, fmt.Errorf("function example file %q does not call %q", path, expectedName))
+	}
+
+	return result
+}
+
+func (check *FunctionExampleCheck) argumentCount(call *hclsyntax.FunctionCallExpr) error {
+	signature := check.Options.Signature
+	if signature == nil {
+		return nil
+	}
+
+	got := len(call.Args)
+	want := len(signature.Parameters)
+
+	// An expanded final argument can expand to any number of arguments, so
+	// only the arguments before it are known.
+	if call.ExpandFinal {
+		got--
+
+		if signature.VariadicParameter == nil && got > want {
+			return fmt.Errorf("call to %q has %d arguments, expected %d", call.Name, got, want)
+		}
+
+		return nil
+	}
+
+	if signature.VariadicParameter != nil {
+		if got < want {
+			return fmt.Errorf("call to %q has %d arguments, expected at least %d", call.Name, got, want)
+		}
+
+		return nil
+	}
+
+	if got != want {
+		return fmt.Errorf("call to %q has %d arguments, expected %d", call.Name, got, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestFunctionExampleCheck(t *testing.T) {
+	t.Parallel()
+
+	signature := &tfjson.FunctionSignature{
+		ReturnType: cty.String,
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name: "input",
+				Type: cty.String,
+			},
+		},
+	}
+
+	variadicSignature := &tfjson.FunctionSignature{
+		ReturnType: cty.String,
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name: "input",
+				Type: cty.String,
+			},
+		},
+		VariadicParameter: &tfjson.FunctionParameter{
+			Name: "more",
+			Type: cty.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		Source      string
+		Signature   *tfjson.FunctionSignature
+		ExpectError bool
+	}{
+		"valid call": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo("hello")
+}
+`,
+			Signature: signature,
+		},
+		"valid nested call": {
+			Source: `
+locals {
+  value = upper(provider::scaffolding::echo("hello"))
+}
+`,
+			Signature: signature,
+		},
+		"valid variadic call": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo("hello", "world", "!")
+}
+`,
+			Signature: variadicSignature,
+		},
+		"valid expanded call": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo(["hello"]...)
+}
+`,
+			Signature: signature,
+		},
+		"invalid HCL": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo("hello"
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"missing call": {
+			Source: `
+output "example" {
+  value = "hello"
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"missing namespace": {
+			Source: `
+output "example" {
+  value = echo("hello")
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"wrong namespace": {
+			Source: `
+output "example" {
+  value = provider::other::echo("hello")
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"too many arguments": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo("hello", "world")
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"too few arguments": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo()
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+		"too few variadic arguments": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo()
+}
+`,
+			Signature:   variadicSignature,
+			ExpectError: true,
+		},
+		"too many arguments before expansion": {
+			Source: `
+output "example" {
+  value = provider::scaffolding::echo("hello", "world", ["!"]...)
+}
+`,
+			Signature:   signature,
+			ExpectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewFunctionExampleCheck(&FunctionExampleOptions{
+				FunctionName:      "echo",
+				ProviderShortName: "scaffolding",
+				Signature:         testCase.Signature,
+			}).Run("function.tf", []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+type IdentityExampleCheck struct {
+	Options *IdentityExampleOptions
+}
+
+// IdentityExampleOptions represents configuration options for IdentityExample.
+type IdentityExampleOptions struct {
+	// ExamplesDir is the examples directory containing the resource example
+	// directories (e.g. "examples").
+	ExamplesDir string
+
+	Schema *tfjson.ProviderSchema
+}
+
+func NewIdentityExampleCheck(opts *IdentityExampleOptions) *IdentityExampleCheck {
+	check := &IdentityExampleCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &IdentityExampleOptions{}
+	}
+
+	return check
+}
+
+// Run verifies every resource with an identity schema has an identity import
+// example file (import-by-identity.tf) in the given example files.
+func (check *IdentityExampleCheck) Run(files []string) error {
+	var result error
+
+	if check.Options.Schema == nil {
+		log.Printf("[DEBUG] Skipping identity example checks due to missing provider schema")
+		return nil
+	}
+
+	found := make(map[string]bool, len(files))
+	for _, file := range files {
+		found[filepath.ToSlash(file)] = true
+	}
+
+	names := make([]string, 0, len(check.Options.Schema.ResourceIdentitySchemas))
+	for name := range check.Options.Schema.ResourceIdentitySchemas {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := check.Options.Schema.ResourceSchemas[name]; !ok {
+			continue
+		}
+
+		file := filepath.ToSlash(filepath.Join(check.Options.ExamplesDir, "resources", name, "import-by-identity.tf"))
+		if !found[file] {
+			result = errors.Join(result, fmt.Errorf("resource %q has an identity schema, but is missing an identity import example file %q", name, file))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestIdentityExampleCheck(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"test_pet":   {},
+			"test_thing": {},
+			"test_other": {},
+		},
+		ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+			"test_pet":   {},
+			"test_thing": {},
+		},
+	}
+
+	testCases := map[string]struct {
+		Files         []string
+		Schema        *tfjson.ProviderSchema
+		ExpectedError string
+	}{
+		"all identity examples": {
+			Files: []string{
+				"examples/resources/test_pet/import-by-identity.tf",
+				"examples/resources/test_thing/import-by-identity.tf",
+			},
+			Schema: schema,
+		},
+		"missing identity examples": {
+			Files: []string{
+				"examples/resources/test_other/import-by-identity.tf",
+			},
+			Schema: schema,
+			ExpectedError: "resource \"test_pet\" has an identity schema, but is missing an identity import example file \"examples/resources/test_pet/import-by-identity.tf\"\n" +
+				"resource \"test_thing\" has an identity schema, but is missing an identity import example file \"examples/resources/test_thing/import-by-identity.tf\"",
+		},
+		"missing schema": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewIdentityExampleCheck(&IdentityExampleOptions{
+				ExamplesDir: "examples",
+				Schema:      testCase.Schema,
+			}).Run(testCase.Files)
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
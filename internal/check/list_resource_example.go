@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+type ListResourceExampleCheck struct {
+	Options *ListResourceExampleOptions
+}
+
+// ListResourceExampleOptions represents configuration options for ListResourceExample.
+type ListResourceExampleOptions struct {
+	// ListResourceName is the name of the list resource (e.g.
+	// "examplecloud_thing").
+	ListResourceName string
+}
+
+func NewListResourceExampleCheck(opts *ListResourceExampleOptions) *ListResourceExampleCheck {
+	check := &ListResourceExampleCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ListResourceExampleOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the given list resource example file (.tfquery.hcl) parses
+// and contains a list block of the list resource.
+func (check *ListResourceExampleCheck) Run(path string, src []byte) error {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return fmt.Errorf("error parsing list resource example file %q: %w", path, diags)
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return fmt.Errorf("error parsing list resource example file %q: unexpected body type %T", path, file.Body)
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == "list" && len(block.Labels) > 0 && block.Labels[0] == check.Options.ListResourceName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("list resource example file %q does not contain a list block for %q", path, check.Options.ListResourceName)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+)
+
+func TestListResourceExampleCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Source      string
+		ExpectError bool
+	}{
+		"valid list block": {
+			Source: `
+list "scaffolding_example" "example" {
+  provider = scaffolding
+
+  config {
+    filter = "example"
+  }
+}
+`,
+		},
+		"valid list block with other blocks": {
+			Source: `
+provider "scaffolding" {}
+
+list "scaffolding_other" "example" {
+  provider = scaffolding
+}
+
+list "scaffolding_example" "example" {
+  provider = scaffolding
+}
+`,
+		},
+		"invalid HCL": {
+			Source: `
+list "scaffolding_example" "example" {
+  provider = scaffolding
+`,
+			ExpectError: true,
+		},
+		"missing list block": {
+			Source: `
+list "scaffolding_other" "example" {
+  provider = scaffolding
+}
+`,
+			ExpectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewListResourceExampleCheck(&ListResourceExampleOptions{
+				ListResourceName: "scaffolding_example",
+			}).Run("list-resource.tfquery.hcl", []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+type StateStoreExampleCheck struct {
+	Options *StateStoreExampleOptions
+}
+
+// StateStoreExampleOptions represents configuration options for StateStoreExample.
+type StateStoreExampleOptions struct {
+	// StateStoreName is the name of the state store (e.g.
+	// "examplecloud_thing").
+	StateStoreName string
+}
+
+func NewStateStoreExampleCheck(opts *StateStoreExampleOptions) *StateStoreExampleCheck {
+	check := &StateStoreExampleCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &StateStoreExampleOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the given state store example file parses and contains a
+// state_store block of the state store, nested in a terraform block, which
+// configures the provider of the state store with a nested provider block.
+func (check *StateStoreExampleCheck) Run(path string, src []byte) error {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return fmt.Errorf("error parsing state store example file %q: %w", path, diags)
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return fmt.Errorf("error parsing state store example file %q: unexpected body type %T", path, file.Body)
+	}
+
+	for _, terraform := range body.Blocks {
+		if terraform.Type != "terraform" {
+			continue
+		}
+
+		for _, stateStore := range terraform.Body.Blocks {
+			if stateStore.Type != "state_store" || len(stateStore.Labels) == 0 || stateStore.Labels[0] != check.Options.StateStoreName {
+				continue
+			}
+
+			for _, provider := range stateStore.Body.Blocks {
+				if provider.Type == "provider" {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("%s: state_store block for %q does not contain a provider block", stateStore.TypeRange, check.Options.StateStoreName)
+		}
+	}
+
+	return fmt.Errorf("state store example file %q does not contain a terraform state_store block for %q", path, check.Options.StateStoreName)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+)
+
+func TestStateStoreExampleCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Source      string
+		ExpectError bool
+	}{
+		"valid state_store block": {
+			Source: `
+terraform {
+  state_store "scaffolding_example" {
+    provider "scaffolding" {}
+
+    bucket = "example"
+  }
+}
+`,
+		},
+		"valid state_store block with other blocks": {
+			Source: `
+terraform {
+  required_providers {
+    scaffolding = {
+      source = "registry.terraform.io/hashicorp/scaffolding"
+    }
+  }
+
+  state_store "scaffolding_example" {
+    provider "scaffolding" {}
+  }
+}
+
+resource "scaffolding_example" "example" {}
+`,
+		},
+		"invalid HCL": {
+			Source: `
+terraform {
+  state_store "scaffolding_example" {
+`,
+			ExpectError: true,
+		},
+		"missing state_store block": {
+			Source: `
+terraform {
+  state_store "scaffolding_other" {
+    provider "scaffolding" {}
+  }
+}
+`,
+			ExpectError: true,
+		},
+		"state_store block outside terraform block": {
+			Source: `
+state_store "scaffolding_example" {
+  provider "scaffolding" {}
+}
+`,
+			ExpectError: true,
+		},
+		"missing provider block": {
+			Source: `
+terraform {
+  state_store "scaffolding_example" {
+    bucket = "example"
+  }
+}
+`,
+			ExpectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewStateStoreExampleCheck(&StateStoreExampleOptions{
+				StateStoreName: "scaffolding_example",
+			}).Run("state-store.tf", []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
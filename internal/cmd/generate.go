@@ -14,7 +14,24 @@ import (
 type generateCmd struct {
 	commonCmd
 
-	flagIgnoreDeprecated bool
+	flagIgnoreDeprecated        bool
+	flagLinkReferences          bool
+	flagOverview                bool
+	flagDefaultIdentityImport   bool
+	flagTypeConstraints         bool
+	flagEscapePlainDescriptions bool
+	flagSchemaBlocksLast        bool
+	flagDisableDefaultID        bool
+
+	flagAllowedResourceSubcategories     string
+	flagAllowedResourceSubcategoriesFile string
+	flagSubcategoryRulesFile             string
+
+	flagSchemaGroups        string
+	flagSchemaOrderFile     string
+	flagSchemaOverridesFile string
+	flagSchemaMetadataFile  string
+	flagIDDescription       string
 
 	flagProviderName         string
 	flagRenderedProviderName string
@@ -25,7 +42,17 @@ type generateCmd struct {
 	flagExamplesDir        string
 	flagWebsiteTmpDir      string
 	flagWebsiteSourceDir   string
+	flagCdktfLanguages     string
+	flagFrontMatterSchema  string
 	tfVersion              string
+	tfBinary               string
+	tfCacheDir             string
+	offline                bool
+
+	flagProviderBuildFlags    string
+	flagProviderBuildEnv      string
+	flagProviderBinary        string
+	flagProviderSourceAddress string
 }
 
 func (cmd *generateCmd) Synopsis() string {
@@ -81,7 +108,31 @@ func (cmd *generateCmd) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagWebsiteTmpDir, "website-temp-dir", "", "temporary directory (used during generation)")
 	fs.StringVar(&cmd.flagWebsiteSourceDir, "website-source-dir", "templates", "templates directory based on provider-dir")
 	fs.StringVar(&cmd.tfVersion, "tf-version", "", "terraform binary version to download. If not provided, will look for a terraform binary in the local environment. If not found in the environment, will download the latest version of Terraform")
+	fs.StringVar(&cmd.tfBinary, "tf-binary", "", "path to a terraform binary to use instead of looking for a terraform binary in the local environment or downloading one; cannot be used with --tf-version")
+	fs.StringVar(&cmd.tfCacheDir, "tf-cache-dir", "", "directory of downloaded terraform binaries, by version; defaults to the tfplugindocs/terraform directory in the user cache directory")
+	fs.BoolVar(&cmd.offline, "offline", false, "disable downloads of terraform binaries and Go modules; fails if no terraform binary is in the local environment or the cache")
+	fs.StringVar(&cmd.flagProviderBuildFlags, "provider-build-flags", "", "space separated list of additional go build flags used to build the provider (ex. \"-tags=integration -trimpath\")")
+	fs.StringVar(&cmd.flagProviderBuildEnv, "provider-build-env", "", "comma separated list of additional KEY=VALUE environment variables used to build the provider; commas not followed by KEY= are part of the value (ex. \"CGO_ENABLED=0,GOFLAGS=-tags=integration,acc\")")
+	fs.StringVar(&cmd.flagProviderBinary, "provider-binary", "", "path to a previously built provider binary to use instead of building the provider; cannot be used with --providers-schema")
+	fs.StringVar(&cmd.flagProviderSourceAddress, "provider-source-address", "", "provider source address (ex. \"registry.terraform.io/example/scaffolding\"); defaults to the hashicorp namespace of the provider short name")
+	fs.StringVar(&cmd.flagCdktfLanguages, "cdktf-languages", "", "comma separated list of CDKTF languages (csharp, go, java, python, typescript) to generate documentation for")
+	fs.StringVar(&cmd.flagFrontMatterSchema, "frontmatter-schema-file", "", "path to YAML file of custom frontmatter key values for templates")
+	fs.StringVar(&cmd.flagSubcategoryRulesFile, "subcategory-rules-file", "", "path to YAML file of rules assigning frontmatter subcategories to generated pages")
+	fs.StringVar(&cmd.flagAllowedResourceSubcategories, "allowed-resource-subcategories", "", "comma separated list of allowed resource frontmatter subcategories")
+	fs.StringVar(&cmd.flagAllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "path to newline separated file of allowed resource frontmatter subcategories")
 	fs.BoolVar(&cmd.flagIgnoreDeprecated, "ignore-deprecated", false, "don't generate documentation for deprecated resources and data-sources")
+	fs.BoolVar(&cmd.flagLinkReferences, "link-references", false, "link references to resources, data sources and functions in generated documentation to their pages")
+	fs.BoolVar(&cmd.flagOverview, "overview", false, "include an overview of all resources, data sources, functions, etc. grouped by subcategory in the provider index page")
+	fs.BoolVar(&cmd.flagDefaultIdentityImport, "default-identity-import", false, "generate an identity import example from the resource identity schema for resources without an import-by-identity.tf example")
+	fs.BoolVar(&cmd.flagTypeConstraints, "type-constraints", false, "include the Terraform type constraint syntax of attributes next to their type in generated documentation")
+	fs.BoolVar(&cmd.flagEscapePlainDescriptions, "escape-plain-descriptions", false, "escape Markdown characters (ex. * and _) in plain schema descriptions, so they are rendered as written")
+	fs.StringVar(&cmd.flagSchemaGroups, "schema-groups", "", "comma separated list of additional attribute groups (write-only, sensitive) in generated schema documentation")
+	fs.BoolVar(&cmd.flagSchemaBlocksLast, "schema-blocks-last", false, "write blocks after attributes within each group of generated schema documentation")
+	fs.StringVar(&cmd.flagSchemaOrderFile, "schema-order-file", "", "path to a YAML file listing the attributes and blocks to write first in the generated schema documentation of each resource, data source, etc.")
+	fs.StringVar(&cmd.flagIDDescription, "id-description", "", "description of top-level id attributes without a description in generated schema documentation, instead of the default (ex. \"The ID of this data source.\")")
+	fs.BoolVar(&cmd.flagDisableDefaultID, "disable-default-id", false, "document top-level id attributes without a description like other attributes, instead of as Read-Only with a default description")
+	fs.StringVar(&cmd.flagSchemaOverridesFile, "schema-overrides-file", "", "path to a YAML file of attribute and block description, Markdown and example overrides in the generated schema documentation of each resource, data source, etc.")
+	fs.StringVar(&cmd.flagSchemaMetadataFile, "schema-metadata-file", "", "path to a JSON file of attribute and block default values and validation constraints in the generated schema documentation of each resource, data source, etc.")
 	return fs
 }
 
@@ -97,6 +148,33 @@ func (cmd *generateCmd) Run(args []string) int {
 }
 
 func (cmd *generateCmd) runInternal() error {
+	opts := provider.GeneratorOptions{
+		CdktfLanguages:                   cmd.flagCdktfLanguages,
+		FrontMatterSchemaFile:            cmd.flagFrontMatterSchema,
+		SubcategoryRulesFile:             cmd.flagSubcategoryRulesFile,
+		AllowedResourceSubcategories:     cmd.flagAllowedResourceSubcategories,
+		AllowedResourceSubcategoriesFile: cmd.flagAllowedResourceSubcategoriesFile,
+		LinkReferences:                   cmd.flagLinkReferences,
+		Overview:                         cmd.flagOverview,
+		DefaultIdentityImport:            cmd.flagDefaultIdentityImport,
+		TypeConstraints:                  cmd.flagTypeConstraints,
+		EscapePlainDescriptions:          cmd.flagEscapePlainDescriptions,
+		SchemaGroups:                     cmd.flagSchemaGroups,
+		SchemaBlocksLast:                 cmd.flagSchemaBlocksLast,
+		SchemaOrderFile:                  cmd.flagSchemaOrderFile,
+		SchemaOverridesFile:              cmd.flagSchemaOverridesFile,
+		SchemaMetadataFile:               cmd.flagSchemaMetadataFile,
+		IDDescription:                    cmd.flagIDDescription,
+		DisableDefaultID:                 cmd.flagDisableDefaultID,
+		TerraformBinary:                  cmd.tfBinary,
+		TerraformCacheDir:                cmd.tfCacheDir,
+		Offline:                          cmd.offline,
+		ProviderBuildFlags:               cmd.flagProviderBuildFlags,
+		ProviderBuildEnv:                 cmd.flagProviderBuildEnv,
+		ProviderBinary:                   cmd.flagProviderBinary,
+		ProviderSourceAddress:            cmd.flagProviderSourceAddress,
+	}
+
 	err := provider.Generate(
 		cmd.ui,
 		cmd.flagProviderDir,
@@ -109,6 +187,7 @@ func (cmd *generateCmd) runInternal() error {
 		cmd.flagWebsiteSourceDir,
 		cmd.tfVersion,
 		cmd.flagIgnoreDeprecated,
+		opts,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to generate website: %w", err)
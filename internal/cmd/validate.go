@@ -19,10 +19,21 @@ type validateCmd struct {
 	flagAllowedGuideSubcategoriesFile    string
 	flagAllowedResourceSubcategories     string
 	flagAllowedResourceSubcategoriesFile string
+	flagFrontMatterSchemaFile            string
+	flagValidateExamples                 bool
+	flagDefaultIdentityImport            bool
 	flagProviderName                     string
 	flagProviderDir                      string
 	flagProvidersSchema                  string
 	tfVersion                            string
+	tfBinary                             string
+	tfCacheDir                           string
+	offline                              bool
+
+	flagProviderBuildFlags    string
+	flagProviderBuildEnv      string
+	flagProviderBinary        string
+	flagProviderSourceAddress string
 }
 
 func (cmd *validateCmd) Synopsis() string {
@@ -73,10 +84,20 @@ func (cmd *validateCmd) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagAllowedGuideSubcategoriesFile, "allowed-guide-subcategories-file", "", "path to newline separated file of allowed guide frontmatter subcategories")
 	fs.StringVar(&cmd.flagAllowedResourceSubcategories, "allowed-resource-subcategories", "", "comma separated list of allowed resource frontmatter subcategories")
 	fs.StringVar(&cmd.flagAllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "path to newline separated file of allowed resource frontmatter subcategories")
+	fs.StringVar(&cmd.flagFrontMatterSchemaFile, "frontmatter-schema-file", "", "path to YAML file of custom frontmatter keys to validate")
+	fs.BoolVar(&cmd.flagValidateExamples, "validate-examples", false, "validate the function, list resource, state store and resource identity import example files")
+	fs.BoolVar(&cmd.flagDefaultIdentityImport, "default-identity-import", false, "skip the identity import example check, for documentation generated with --default-identity-import")
 	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; defaults to the --provider-dir short name (after removing `terraform-provider-` prefix)")
 	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory; this will default to the current working directory if not set")
 	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command. Setting this flag will skip building the provider and calling Terraform CLI")
 	fs.StringVar(&cmd.tfVersion, "tf-version", "", "terraform binary version to download. If not provided, will look for a terraform binary in the local environment. If not found in the environment, will download the latest version of Terraform")
+	fs.StringVar(&cmd.tfBinary, "tf-binary", "", "path to a terraform binary to use instead of looking for a terraform binary in the local environment or downloading one; cannot be used with --tf-version")
+	fs.StringVar(&cmd.tfCacheDir, "tf-cache-dir", "", "directory of downloaded terraform binaries, by version; defaults to the tfplugindocs/terraform directory in the user cache directory")
+	fs.BoolVar(&cmd.offline, "offline", false, "disable downloads of terraform binaries and Go modules; fails if no terraform binary is in the local environment or the cache")
+	fs.StringVar(&cmd.flagProviderBuildFlags, "provider-build-flags", "", "space separated list of additional go build flags used to build the provider (ex. \"-tags=integration -trimpath\")")
+	fs.StringVar(&cmd.flagProviderBuildEnv, "provider-build-env", "", "comma separated list of additional KEY=VALUE environment variables used to build the provider; commas not followed by KEY= are part of the value (ex. \"CGO_ENABLED=0,GOFLAGS=-tags=integration,acc\")")
+	fs.StringVar(&cmd.flagProviderBinary, "provider-binary", "", "path to a previously built provider binary to use instead of building the provider; cannot be used with --providers-schema")
+	fs.StringVar(&cmd.flagProviderSourceAddress, "provider-source-address", "", "provider source address (ex. \"registry.terraform.io/example/scaffolding\"); defaults to the hashicorp namespace of the provider short name")
 	return fs
 }
 
@@ -97,6 +118,16 @@ func (cmd *validateCmd) runInternal() error {
 		AllowedGuideSubcategoriesFile:    cmd.flagAllowedGuideSubcategoriesFile,
 		AllowedResourceSubcategories:     cmd.flagAllowedResourceSubcategories,
 		AllowedResourceSubcategoriesFile: cmd.flagAllowedResourceSubcategoriesFile,
+		FrontMatterSchemaFile:            cmd.flagFrontMatterSchemaFile,
+		ValidateExamples:                 cmd.flagValidateExamples,
+		DefaultIdentityImport:            cmd.flagDefaultIdentityImport,
+		TerraformBinary:                  cmd.tfBinary,
+		TerraformCacheDir:                cmd.tfCacheDir,
+		Offline:                          cmd.offline,
+		ProviderBuildFlags:               cmd.flagProviderBuildFlags,
+		ProviderBuildEnv:                 cmd.flagProviderBuildEnv,
+		ProviderBinary:                   cmd.flagProviderBinary,
+		ProviderSourceAddress:            cmd.flagProviderSourceAddress,
 	}
 
 	err := provider.Validate(cmd.ui,
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functionmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+const (
+	// parameterAnchorPrefix and returnAnchorPrefix are the anchor ID
+	// prefixes of the nested types of parameters and the return value, which
+	// are distinct so a parameter named "return" does not collide with the
+	// return value.
+	parameterAnchorPrefix = "nestedtype"
+	returnAnchorPrefix    = "nestedreturntype"
+
+	// returnPathTitle is the nested type path title of the return value.
+	returnPathTitle = "return"
+)
+
+type nestedType struct {
+	anchorID     string
+	anchorPrefix string
+	pathTitle    string
+	path         []string
+	ty           cty.Type
+}
+
+// RenderNestedTypes returns a Markdown formatted string of the object
+// attribute and tuple element types of the function parameters, variadic
+// parameter and return value, or an empty string if there are none.
+func RenderNestedTypes(signature *tfjson.FunctionSignature) (string, error) {
+	return renderNestedTypes(append(argumentNestedTypes(signature), returnNestedTypes(signature)...))
+}
+
+// RenderArgumentNestedTypes returns a Markdown formatted string of the object
+// attribute and tuple element types of the function parameters and variadic
+// parameter, or an empty string if there are none.
+func RenderArgumentNestedTypes(signature *tfjson.FunctionSignature) (string, error) {
+	return renderNestedTypes(argumentNestedTypes(signature))
+}
+
+// RenderReturnNestedTypes returns a Markdown formatted string of the object
+// attribute and tuple element types of the function return value, or an
+// empty string if there are none.
+func RenderReturnNestedTypes(signature *tfjson.FunctionSignature) (string, error) {
+	return renderNestedTypes(returnNestedTypes(signature))
+}
+
+func argumentNestedTypes(signature *tfjson.FunctionSignature) []nestedType {
+	nestedTypes := []nestedType{}
+
+	for _, p := range signature.Parameters {
+		if nt, ok := newNestedType(parameterAnchorPrefix, []string{p.Name}, p.Name, p.Type); ok {
+			nestedTypes = append(nestedTypes, nt)
+		}
+	}
+
+	if p := signature.VariadicParameter; p != nil {
+		if nt, ok := newNestedType(parameterAnchorPrefix, []string{p.Name}, p.Name, p.Type); ok {
+			nestedTypes = append(nestedTypes, nt)
+		}
+	}
+
+	return nestedTypes
+}
+
+func returnNestedTypes(signature *tfjson.FunctionSignature) []nestedType {
+	if nt, ok := newNestedType(returnAnchorPrefix, nil, returnPathTitle, signature.ReturnType); ok {
+		return []nestedType{nt}
+	}
+
+	return nil
+}
+
+func renderNestedTypes(nestedTypes []nestedType) (string, error) {
+	typeBuffer := bytes.NewBuffer(nil)
+
+	err := writeNestedTypes(typeBuffer, nestedTypes)
+	if err != nil {
+		return "", err
+	}
+
+	return typeBuffer.String(), nil
+}
+
+// nestedTypeLink returns a link to the nested type section of the given
+// path, or an empty string if the type has no nested type section.
+func nestedTypeLink(anchorPrefix string, path []string, ty cty.Type) string {
+	if !isNestedType(ty) {
+		return ""
+	}
+
+	return " (see [below for nested type](#" + nestedTypeAnchorID(anchorPrefix, path) + "))"
+}
+
+func nestedTypeAnchorID(anchorPrefix string, path []string) string {
+	if len(path) == 0 {
+		return anchorPrefix
+	}
+
+	return anchorPrefix + "--" + strings.Join(path, "--")
+}
+
+func newNestedType(anchorPrefix string, path []string, pathTitle string, ty cty.Type) (nestedType, bool) {
+	if !isNestedType(ty) {
+		return nestedType{}, false
+	}
+
+	return nestedType{
+		anchorID:     nestedTypeAnchorID(anchorPrefix, path),
+		anchorPrefix: anchorPrefix,
+		pathTitle:    pathTitle,
+		path:         path,
+		ty:           elementType(ty),
+	}, true
+}
+
+// isNestedType returns true if the type, or the element type of a
+// collection type, is an object or tuple type.
+func isNestedType(ty cty.Type) bool {
+	if ty == cty.NilType {
+		return false
+	}
+
+	ty = elementType(ty)
+
+	return ty.IsObjectType() || ty.IsTupleType()
+}
+
+// elementType returns the innermost element type of (nested) collection
+// types, otherwise the type itself.
+func elementType(ty cty.Type) cty.Type {
+	for ty.IsCollectionType() {
+		ty = ty.ElementType()
+	}
+
+	return ty
+}
+
+func writeNestedTypes(w io.Writer, nestedTypes []nestedType) error {
+	for _, nt := range nestedTypes {
+		_, err := io.WriteString(w, "<a id=\""+nt.anchorID+"\"></a>\n")
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "### Nested Type for `"+nt.pathTitle+"`\n\n")
+		if err != nil {
+			return err
+		}
+
+		children := []nestedType{}
+
+		switch {
+		case nt.ty.IsObjectType():
+			atts := nt.ty.AttributeTypes()
+			sortedNames := []string{}
+			for n := range atts {
+				sortedNames = append(sortedNames, n)
+			}
+			sort.Strings(sortedNames)
+
+			for _, name := range sortedNames {
+				path := childPath(nt.path, name)
+
+				child, err := writeNestedTypeElement(w, nt.anchorPrefix, path, nt.pathTitle+"."+name, name, atts[name], nt.ty.AttributeOptional(name))
+				if err != nil {
+					return fmt.Errorf("unable to render attribute %q: %w", name, err)
+				}
+
+				children = append(children, child...)
+			}
+		case nt.ty.IsTupleType():
+			for i, elem := range nt.ty.TupleElementTypes() {
+				index := strconv.Itoa(i)
+				path := childPath(nt.path, index)
+
+				child, err := writeNestedTypeElement(w, nt.anchorPrefix, path, nt.pathTitle+"["+index+"]", "["+index+"]", elem, false)
+				if err != nil {
+					return fmt.Errorf("unable to render tuple element %d: %w", i, err)
+				}
+
+				children = append(children, child...)
+			}
+		default:
+			return fmt.Errorf("unexpected nested type %q: %s", nt.ty.FriendlyName(), nt.pathTitle)
+		}
+
+		_, err = io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+
+		err = writeNestedTypes(w, children)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeNestedTypeElement(w io.Writer, anchorPrefix string, path []string, pathTitle, name string, ty cty.Type, optional bool) ([]nestedType, error) {
+	_, err := io.WriteString(w, "- `"+name+"` (")
+	if err != nil {
+		return nil, err
+	}
+
+	err = schemamd.WriteType(w, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	if optional {
+		_, err = io.WriteString(w, ", Optional")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = io.WriteString(w, ")"+nestedTypeLink(anchorPrefix, path, ty)+"\n")
+	if err != nil {
+		return nil, err
+	}
+
+	if nt, ok := newNestedType(anchorPrefix, path, pathTitle, ty); ok {
+		return []nestedType{nt}, nil
+	}
+
+	return nil, nil
+}
+
+func childPath(parents []string, name string) []string {
+	path := make([]string, len(parents), len(parents)+1)
+	copy(path, parents)
+
+	return append(path, name)
+}
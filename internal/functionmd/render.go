@@ -14,11 +14,18 @@ import (
 )
 
 // RenderArguments returns a Markdown formatted string of the function arguments.
-func RenderArguments(signature *tfjson.FunctionSignature) (string, error) {
+// If nestedTypeLinks is true, arguments with object or tuple types link to
+// their section in RenderArgumentNestedTypes or RenderNestedTypes, which must
+// then be rendered on the same page.
+func RenderArguments(signature *tfjson.FunctionSignature, nestedTypeLinks bool) (string, error) {
 	argBuffer := bytes.NewBuffer(nil)
 	for i, p := range signature.Parameters {
 		name := p.Name
-		desc := strings.TrimSpace(p.Description)
+		desc := schemamd.DescriptionMarkdown(p.Description, "", "   ", schemamd.Options{})
+		if nestedTypeLinks {
+			desc += nestedTypeLink(parameterAnchorPrefix, []string{name}, p.Type)
+		}
+		desc = strings.TrimSpace(desc)
 
 		typeBuffer := bytes.NewBuffer(nil)
 		err := schemamd.WriteType(typeBuffer, p.Type)
@@ -72,14 +79,19 @@ func RenderSignature(funcName string, signature *tfjson.FunctionSignature) (stri
 }
 
 // RenderVariadicArg returns a Markdown formatted string of the variadic argument if it exists,
-// otherwise an empty string.
-func RenderVariadicArg(signature *tfjson.FunctionSignature) (string, error) {
+// otherwise an empty string. If nestedTypeLinks is true, an object or tuple type links to its
+// section in RenderArgumentNestedTypes or RenderNestedTypes.
+func RenderVariadicArg(signature *tfjson.FunctionSignature, nestedTypeLinks bool) (string, error) {
 	if signature.VariadicParameter == nil {
 		return "", nil
 	}
 
 	name := signature.VariadicParameter.Name
-	desc := strings.TrimSpace(signature.VariadicParameter.Description)
+	desc := schemamd.DescriptionMarkdown(signature.VariadicParameter.Description, "", "   ", schemamd.Options{})
+	if nestedTypeLinks {
+		desc += nestedTypeLink(parameterAnchorPrefix, []string{name}, signature.VariadicParameter.Type)
+	}
+	desc = strings.TrimSpace(desc)
 
 	typeBuffer := bytes.NewBuffer(nil)
 	err := schemamd.WriteType(typeBuffer, signature.VariadicParameter.Type)
@@ -94,3 +106,56 @@ func RenderVariadicArg(signature *tfjson.FunctionSignature) (string, error) {
 	}
 
 }
+
+// RenderReturnType returns a Markdown formatted string of the function return type.
+// If nestedTypeLinks is true, an object or tuple type links to its section in
+// RenderReturnNestedTypes or RenderNestedTypes.
+func RenderReturnType(signature *tfjson.FunctionSignature, nestedTypeLinks bool) (string, error) {
+	typeBuffer := bytes.NewBuffer(nil)
+	err := schemamd.WriteType(typeBuffer, signature.ReturnType)
+	if err != nil {
+		return "", err
+	}
+
+	var link string
+	if nestedTypeLinks {
+		link = nestedTypeLink(returnAnchorPrefix, nil, signature.ReturnType)
+	}
+
+	return fmt.Sprintf("The return type is `%s`%s.", typeBuffer.String(), link), nil
+}
+
+// CallName returns the name used to call the function in Terraform
+// configuration (e.g. "provider::time::parse_rfc3339").
+func CallName(providerShortName, funcName string) string {
+	return fmt.Sprintf("provider::%s::%s", providerShortName, funcName)
+}
+
+// RenderCallExample returns a Markdown formatted Terraform configuration
+// example calling the function with input variables for each parameter. The
+// variadic parameter, if any, is a list variable expanded with "...".
+func RenderCallExample(callName string, signature *tfjson.FunctionSignature) (string, error) {
+	argBuffer := bytes.NewBuffer(nil)
+	for i, p := range signature.Parameters {
+		if i != 0 {
+			argBuffer.WriteString(", ")
+		}
+
+		argBuffer.WriteString("var." + p.Name)
+	}
+
+	if p := signature.VariadicParameter; p != nil {
+		if len(signature.Parameters) != 0 {
+			argBuffer.WriteString(", ")
+		}
+
+		argBuffer.WriteString("var." + p.Name + "...")
+	}
+
+	return fmt.Sprintf("```terraform\n"+
+		"output \"example\" {\n"+
+		"  value = %s(%s)\n"+
+		"}\n"+
+		"```",
+		callName, argBuffer.String()), nil
+}
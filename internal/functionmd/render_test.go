@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform-plugin-docs/internal/functionmd"
 )
@@ -38,7 +39,47 @@ func TestRenderArguments(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	argStr, err := functionmd.RenderArguments(&signature)
+	argStr, err := functionmd.RenderArguments(&signature, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderArguments_nested(t *testing.T) {
+	t.Parallel()
+
+	inputFile := "testdata/nested_types.schema.json"
+	expectedFile := "testdata/example_nested_arguments.md"
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderArguments(&signature, true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,7 +119,7 @@ func TestRenderSignature(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	argStr, err := functionmd.RenderSignature("example", &signature)
+	argStr, err := functionmd.RenderSignature(functionmd.CallName("scaffolding", "example"), &signature)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -118,7 +159,223 @@ func TestRenderVariadicArg(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	argStr, err := functionmd.RenderVariadicArg(&signature)
+	argStr, err := functionmd.RenderVariadicArg(&signature, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderNestedTypes(t *testing.T) {
+	t.Parallel()
+
+	inputFile := "testdata/nested_types.schema.json"
+	expectedFile := "testdata/example_nested_types.md"
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typeStr, err := functionmd.RenderNestedTypes(&signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(typeStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRenderNestedTypes_none(t *testing.T) {
+	t.Parallel()
+
+	signature := tfjson.FunctionSignature{
+		ReturnType: cty.String,
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name: "input",
+				Type: cty.List(cty.String),
+			},
+		},
+	}
+
+	typeStr, err := functionmd.RenderNestedTypes(&signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if typeStr != "" {
+		t.Fatalf("expected empty nested types, got: %s", typeStr)
+	}
+}
+
+func TestRenderNestedTypes_returnParameter(t *testing.T) {
+	t.Parallel()
+
+	signature := tfjson.FunctionSignature{
+		ReturnType: cty.Object(map[string]cty.Type{
+			"value": cty.String,
+		}),
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name: "return",
+				Type: cty.Object(map[string]cty.Type{
+					"enabled": cty.Bool,
+				}),
+			},
+		},
+	}
+
+	expected := "<a id=\"nestedtype--return\"></a>\n" +
+		"### Nested Type for `return`\n\n" +
+		"- `enabled` (Boolean)\n\n" +
+		"<a id=\"nestedreturntype\"></a>\n" +
+		"### Nested Type for `return`\n\n" +
+		"- `value` (String)\n\n"
+
+	typeStr, err := functionmd.RenderNestedTypes(&signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expected, typeStr); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRenderReturnType(t *testing.T) {
+	inputFile := "testdata/function_signature.schema.json"
+	expectedFile := "testdata/example_return_type.md"
+
+	t.Parallel()
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderReturnType(&signature, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderReturnType_nested(t *testing.T) {
+	inputFile := "testdata/nested_types.schema.json"
+	expectedFile := "testdata/example_nested_return_type.md"
+
+	t.Parallel()
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderReturnType(&signature, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderCallExample(t *testing.T) {
+	inputFile := "testdata/function_signature.schema.json"
+	expectedFile := "testdata/example_call_example.md"
+
+	t.Parallel()
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderCallExample(functionmd.CallName("scaffolding", "example"), &signature)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -134,3 +391,29 @@ func TestRenderVariadicArg(t *testing.T) {
 	}
 
 }
+
+func TestRenderCallExample_variadicOnly(t *testing.T) {
+	t.Parallel()
+
+	signature := tfjson.FunctionSignature{
+		ReturnType: cty.String,
+		VariadicParameter: &tfjson.FunctionParameter{
+			Name: "values",
+			Type: cty.String,
+		},
+	}
+
+	got, err := functionmd.RenderCallExample(functionmd.CallName("scaffolding", "example"), &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "```terraform\n" +
+		"output \"example\" {\n" +
+		"  value = provider::scaffolding::example(var.values...)\n" +
+		"}\n" +
+		"```"
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/yuin/goldmark/ast"
 	extAST "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
 )
 
 type TextRender struct{}
@@ -78,7 +79,7 @@ func (r *TextRender) Render(w io.Writer, source []byte, n ast.Node) error {
 			}
 			return ast.WalkSkipChildren, err
 		case *ast.Text:
-			out.Write(node.Text(source))
+			out.Write(util.UnescapePunctuations(node.Text(source)))
 			if node.SoftLineBreak() {
 				doubleSpace(out)
 			}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// actionAddress returns the example address of the given action, as used in
+// lifecycle action_trigger blocks and with the -invoke CLI flag.
+func actionAddress(name string) string {
+	return fmt.Sprintf("action.%s.example", name)
+}
+
+// actionTriggerResources returns the sorted resource types of the resources
+// in the given example files which trigger the given action from a lifecycle
+// action_trigger block.
+func actionTriggerResources(providerDir, name string, files []string) ([]string, error) {
+	var result []string
+
+	for _, file := range files {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(providerDir, file)
+		}
+
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read action trigger example %q: %w", file, err)
+		}
+
+		f, diags := hclsyntax.ParseConfig(src, file, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("unable to parse action trigger example %q: %w", file, diags)
+		}
+
+		body, ok := f.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) == 0 {
+				continue
+			}
+
+			if triggersAction(block.Body, name) && !slices.Contains(result, block.Labels[0]) {
+				result = append(result, block.Labels[0])
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result, nil
+}
+
+// triggersAction returns true if the given resource body has a lifecycle
+// action_trigger block referencing the given action.
+func triggersAction(body *hclsyntax.Body, name string) bool {
+	for _, lifecycle := range body.Blocks {
+		if lifecycle.Type != "lifecycle" {
+			continue
+		}
+
+		for _, trigger := range lifecycle.Body.Blocks {
+			if trigger.Type != "action_trigger" {
+				continue
+			}
+
+			actions, ok := trigger.Body.Attributes["actions"]
+			if !ok {
+				continue
+			}
+
+			for _, traversal := range actions.Expr.Variables() {
+				if traversal.RootName() != "action" || len(traversal) < 2 {
+					continue
+				}
+
+				if attr, ok := traversal[1].(hcl.TraverseAttr); ok && attr.Name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// renderActionInvocationMarkdown returns Markdown formatted documentation on
+// invoking the given action, using the trigger examples if any.
+func renderActionInvocationMarkdown(providerDir, name string, triggerExampleFiles, triggerResources []string) (string, error) {
+	address := actionAddress(name)
+
+	var b strings.Builder
+
+	if len(triggerResources) > 0 {
+		b.WriteString("This action is triggered by the following resources:\n\n")
+
+		for _, resource := range triggerResources {
+			b.WriteString("- `" + resource + "`\n")
+		}
+
+		b.WriteString("\n")
+	}
+
+	if len(triggerExampleFiles) > 0 {
+		b.WriteString("The following examples trigger this action from the `action_trigger` block of a resource `lifecycle` block:\n")
+
+		for _, file := range triggerExampleFiles {
+			code, err := terraformCodeFile(providerDir)(file)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString("\n" + code + "\n")
+		}
+	} else {
+		b.WriteString("This action can be triggered from the `action_trigger` block of a resource `lifecycle` block, for example:\n\n")
+		b.WriteString("```terraform\n")
+		b.WriteString("resource \"terraform_data\" \"example\" {\n")
+		b.WriteString("  lifecycle {\n")
+		b.WriteString("    action_trigger {\n")
+		b.WriteString("      events  = [after_create, after_update]\n")
+		b.WriteString("      actions = [" + address + "]\n")
+		b.WriteString("    }\n")
+		b.WriteString("  }\n")
+		b.WriteString("}\n")
+		b.WriteString("```\n")
+	}
+
+	b.WriteString("\nThis action can also be invoked directly with the Terraform CLI, for example:\n\n")
+	b.WriteString("```shell\n")
+	b.WriteString("terraform apply -invoke=" + address + "\n")
+	b.WriteString("```\n")
+
+	return b.String(), nil
+}
@@ -18,6 +18,7 @@ type ActionTemplateType struct {
 	Type        string
 	Name        string
 	Description string
+	Subcategory string
 
 	HasExample   bool
 	HasExamples  bool
@@ -29,12 +30,41 @@ type ActionTemplateType struct {
 
 	SchemaMarkdown string
 
+	ActionAddress       string
+	HasTriggerExamples  bool
+	TriggerExampleFiles []string
+	TriggerResources    []string
+	InvocationMarkdown  string
+
 	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
 }
 
-func (t actionTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, schema *tfjson.ActionSchema) (string, error) {
-	schemaBuffer := bytes.NewBuffer(nil)
-	err := schemamd.RenderAction(schema, schemaBuffer)
+func (t actionTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles, triggerExampleFiles []string, schema *tfjson.ActionSchema, schemaOpts schemamd.Options, subcategory string, frontMatter map[string]string) (string, error) {
+	renderSchema := func(opts schemamd.Options) (string, error) {
+		schemaBuffer := bytes.NewBuffer(nil)
+		err := schemamd.RenderAction(schema, schemaBuffer, opts)
+		if err != nil {
+			return "", err
+		}
+
+		return actionSchemaComment + "\n" + schemaBuffer.String(), nil
+	}
+
+	schemaMarkdown, err := renderSchema(schemaOpts)
+	if err != nil {
+		return "", err
+	}
+
+	triggerResources, err := actionTriggerResources(providerDir, name, triggerExampleFiles)
+	if err != nil {
+		return "", err
+	}
+
+	invocation, err := renderActionInvocationMarkdown(providerDir, name, triggerExampleFiles, triggerResources)
 	if err != nil {
 		return "", err
 	}
@@ -47,7 +77,8 @@ func (t actionTemplate) Render(providerDir, name, providerName, renderedProvider
 	return renderStringTemplate(providerDir, "actionTemplate", s, ActionTemplateType{
 		Type:        typeName,
 		Name:        name,
-		Description: schema.Block.Description,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
+		Subcategory: subcategory,
 
 		HasExample:   exampleFile != "" && fileExists(exampleFile),
 		HasExamples:  len(exampleFiles) > 0,
@@ -57,17 +88,30 @@ func (t actionTemplate) Render(providerDir, name, providerName, renderedProvider
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(renderedProviderName),
 
-		SchemaMarkdown: actionSchemaComment + "\n" + schemaBuffer.String(),
+		SchemaMarkdown: schemaMarkdown,
+
+		ActionAddress:       actionAddress(name),
+		HasTriggerExamples:  len(triggerExampleFiles) > 0,
+		TriggerExampleFiles: triggerExampleFiles,
+		TriggerResources:    triggerResources,
+		InvocationMarkdown:  invocation,
 
 		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render:        renderSchema,
+		},
 	})
 }
 
 const defaultActionTemplate actionTemplate = `---
 ` + frontmatterComment + `
 page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
-subcategory: ""
-description: |-
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
 {{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
 ---
 
@@ -85,4 +129,8 @@ description: |-
 {{- end }}
 
 {{ .SchemaMarkdown | trimspace }}
+
+## Invoking this action
+
+{{ .InvocationMarkdown | trimspace }}
 `
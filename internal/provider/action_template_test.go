@@ -10,6 +10,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
 )
 
 func TestActionTemplate_Render(t *testing.T) {
@@ -49,7 +51,7 @@ action "scaffolding_example" "example1" {
 		},
 	}
 
-	result, err := tpl.Render("testdata/test-action-dir", "testTemplate", "test-action", "test-action", "action", "action.tf", []string{"action.tf"}, &schema)
+	result, err := tpl.Render("testdata/test-action-dir", "testTemplate", "test-action", "test-action", "action", "action.tf", []string{"action.tf"}, nil, &schema, schemamd.Options{}, "", nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -59,3 +61,54 @@ action "scaffolding_example" "example1" {
 		t.Errorf("expected: %+v, got: %+v", expectedString, cleanedResult)
 	}
 }
+
+func TestActionTemplate_Render_Invocation(t *testing.T) {
+	t.Parallel()
+
+	template := `{{ .ActionAddress }}
+{{ range .TriggerResources }}{{ . }}{{ end }}
+{{ .InvocationMarkdown }}`
+
+	expectedString := "action.testTemplate.example\n" +
+		"scaffolding_thing\n" +
+		"This action is triggered by the following resources:\n\n" +
+		"- `scaffolding_thing`\n\n" +
+		"The following examples trigger this action from the `action_trigger` block of a resource `lifecycle` block:\n\n" +
+		"```terraform\n" +
+		"resource \"scaffolding_thing\" \"example\" {\n" +
+		"  lifecycle {\n" +
+		"    action_trigger {\n" +
+		"      events  = [after_create]\n" +
+		"      actions = [action.testTemplate.example]\n" +
+		"    }\n" +
+		"  }\n" +
+		"}\n\n" +
+		"resource \"scaffolding_other\" \"example\" {\n" +
+		"  lifecycle {\n" +
+		"    action_trigger {\n" +
+		"      events  = [after_create]\n" +
+		"      actions = [action.otherAction.example]\n" +
+		"    }\n" +
+		"  }\n" +
+		"}\n" +
+		"```\n\n" +
+		"This action can also be invoked directly with the Terraform CLI, for example:\n\n" +
+		"```shell\n" +
+		"terraform apply -invoke=action.testTemplate.example\n" +
+		"```\n"
+
+	tpl := actionTemplate(template)
+
+	schema := tfjson.ActionSchema{
+		Block: &tfjson.SchemaBlock{},
+	}
+
+	result, err := tpl.Render("testdata/test-action-dir", "testTemplate", "test-action", "test-action", "action", "action.tf", []string{"action.tf"}, []string{"trigger.tf"}, &schema, schemamd.Options{}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
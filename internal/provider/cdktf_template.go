@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/cdktf"
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+type cdktfResourceTemplate string
+
+type CdktfResourceTemplateType struct {
+	Type        string
+	Name        string
+	Description string
+	Subcategory string
+	Language    string
+
+	HasExample   bool
+	HasExamples  bool
+	ExampleFile  string
+	ExampleFiles []string
+
+	ProviderName      string
+	ProviderShortName string
+
+	SchemaMarkdown string
+
+	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
+}
+
+func (t cdktfResourceTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName string, language cdktf.Language, exampleFile string, exampleFiles []string, schema *tfjson.Schema, schemaOpts schemamd.Options, subcategory string, frontMatter map[string]string) (string, error) {
+	renderSchema := func(opts schemamd.Options) (string, error) {
+		schemaBuffer := bytes.NewBuffer(nil)
+		err := schemamd.Render(language.ConvertSchema(schema), schemaBuffer, cdktfSchemaOptions(opts, language))
+		if err != nil {
+			return "", err
+		}
+
+		return schemaComment + "\n" + schemaBuffer.String(), nil
+	}
+
+	schemaMarkdown, err := renderSchema(schemaOpts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render schema: %w", err)
+	}
+
+	s := string(t)
+	if s == "" {
+		return "", nil
+	}
+
+	return renderStringTemplate(providerDir, "cdktfResourceTemplate", s, CdktfResourceTemplateType{
+		Type:        typeName,
+		Name:        name,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
+		Subcategory: subcategory,
+		Language:    language.Name,
+
+		HasExample:   exampleFile != "" && fileExists(exampleFile),
+		HasExamples:  len(exampleFiles) > 0,
+		ExampleFile:  exampleFile,
+		ExampleFiles: exampleFiles,
+
+		ProviderName:      providerName,
+		ProviderShortName: providerShortName(renderedProviderName),
+
+		SchemaMarkdown: schemaMarkdown,
+
+		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render:        renderSchema,
+		},
+	})
+}
+
+// cdktfSchemaOptions returns the given schema options with the attribute and
+// block paths of the schema order, overrides and metadata converted to the
+// naming convention of the given language, matching the converted schema.
+func cdktfSchemaOptions(opts schemamd.Options, language cdktf.Language) schemamd.Options {
+	if opts.Order != nil {
+		order := make([]string, 0, len(opts.Order))
+		for _, path := range opts.Order {
+			order = append(order, language.ConvertPath(path))
+		}
+		opts.Order = order
+	}
+
+	if opts.Overrides != nil {
+		overrides := make(map[string]schemamd.Override, len(opts.Overrides))
+		for path, ov := range opts.Overrides {
+			overrides[language.ConvertPath(path)] = ov
+		}
+		opts.Overrides = overrides
+	}
+
+	if opts.Metadata != nil {
+		metadata := make(map[string]schemamd.Metadata, len(opts.Metadata))
+		for path, md := range opts.Metadata {
+			if md.ConflictsWith != nil {
+				conflictsWith := make([]string, 0, len(md.ConflictsWith))
+				for _, conflict := range md.ConflictsWith {
+					conflictsWith = append(conflictsWith, language.ConvertPath(conflict))
+				}
+				md.ConflictsWith = conflictsWith
+			}
+			metadata[language.ConvertPath(path)] = md
+		}
+		opts.Metadata = metadata
+	}
+
+	return opts
+}
+
+const defaultCdktfResourceTemplate cdktfResourceTemplate = `---
+` + frontmatterComment + `
+page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
+{{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+---
+
+# {{.Name}} ({{.Type}})
+
+{{ .Description | trimspace }}
+
+{{ if .HasExamples -}}
+## Example Usage
+
+{{- range .ExampleFiles }}
+
+{{ codefile $.Language . }}
+{{- end }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+`
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/functionmd"
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+type docTemplate string
+
+// DocTemplateType is the data of guides and other templates which are not
+// rendered for a specific resource, data source, function, etc.
+type DocTemplateType struct {
+	ProviderName      string
+	ProviderShortName string
+
+	// ProviderSchema is the full provider schema, as exported by the
+	// `terraform providers schema -json` command.
+	ProviderSchema *tfjson.ProviderSchema
+
+	Actions            []string
+	DataSources        []string
+	EphemeralResources []string
+	Functions          []string
+	ListResources      []string
+	Resources          []string
+	StateStores        []string
+
+	Overview         []OverviewEntry
+	OverviewMarkdown string
+
+	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaOptions   schemamd.Options
+	schemaOrder     SchemaOrder
+	schemaOverrides SchemaOverrides
+	schemaMetadata  SchemaMetadata
+}
+
+func newDocTemplateType(providerName, renderedProviderName string, providerSchema *tfjson.ProviderSchema, schemaOpts schemamd.Options, schemaOrder SchemaOrder, schemaOverrides SchemaOverrides, schemaMetadata SchemaMetadata, overview []OverviewEntry, frontMatter map[string]string) DocTemplateType {
+	result := DocTemplateType{
+		ProviderName:      providerName,
+		ProviderShortName: providerShortName(renderedProviderName),
+
+		ProviderSchema: providerSchema,
+
+		Overview:         overview,
+		OverviewMarkdown: renderOverviewMarkdown(overview),
+
+		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaOptions:   schemaOpts,
+		schemaOrder:     schemaOrder,
+		schemaOverrides: schemaOverrides,
+		schemaMetadata:  schemaMetadata,
+	}
+
+	if providerSchema == nil {
+		return result
+	}
+
+	result.Actions = sortedKeys(providerSchema.ActionSchemas)
+	result.DataSources = sortedKeys(providerSchema.DataSourceSchemas)
+	result.EphemeralResources = sortedKeys(providerSchema.EphemeralResourceSchemas)
+	result.Functions = sortedKeys(providerSchema.Functions)
+	result.ListResources = sortedKeys(providerSchema.ListResourceSchemas)
+	result.Resources = sortedKeys(providerSchema.ResourceSchemas)
+	result.StateStores = sortedKeys(providerSchema.StateStoreSchemas)
+
+	return result
+}
+
+// ProviderSchemaMarkdown returns the Markdown formatted provider schema.
+// Schema options (e.g. "blocks-last" or "order=endpoint") can be given to
+// override the grouping and ordering of the schema.
+func (d DocTemplateType) ProviderSchemaMarkdown(options ...string) (string, error) {
+	if d.ProviderSchema == nil || d.ProviderSchema.ConfigSchema == nil {
+		return "", fmt.Errorf("provider schema not found")
+	}
+
+	opts, err := d.schemaOptionsWith("", "provider", options)
+	if err != nil {
+		return "", err
+	}
+
+	return renderSchemaMarkdown(d.ProviderSchema.ConfigSchema, opts)
+}
+
+// ResourceSchemaMarkdown returns the Markdown formatted schema of the given
+// resource (e.g. "scaffolding_example"). Schema options (e.g.
+// "groups=write-only,sensitive") can be given to override the grouping and
+// ordering of the schema.
+func (d DocTemplateType) ResourceSchemaMarkdown(name string, options ...string) (string, error) {
+	return d.schemaMarkdown("resource", "resources", d.schemas().ResourceSchemas, name, options)
+}
+
+// ResourceIdentitySchemaMarkdown returns the Markdown formatted identity
+// schema of the given resource.
+func (d DocTemplateType) ResourceIdentitySchemaMarkdown(name string) (string, error) {
+	identitySchema, ok := d.schemas().ResourceIdentitySchemas[name]
+	if !ok {
+		return "", fmt.Errorf("resource %q identity schema not found in provider schema", name)
+	}
+
+	result, err := renderIdentitySchemaMarkdown(identitySchema, d.schemaOptions)
+	if err != nil {
+		return "", fmt.Errorf("unable to render resource %q identity schema: %w", name, err)
+	}
+
+	return result, nil
+}
+
+// DataSourceSchemaMarkdown returns the Markdown formatted schema of the
+// given data source.
+func (d DocTemplateType) DataSourceSchemaMarkdown(name string, options ...string) (string, error) {
+	return d.schemaMarkdown("data source", "data-sources", d.schemas().DataSourceSchemas, name, options)
+}
+
+// EphemeralResourceSchemaMarkdown returns the Markdown formatted schema of
+// the given ephemeral resource.
+func (d DocTemplateType) EphemeralResourceSchemaMarkdown(name string, options ...string) (string, error) {
+	return d.schemaMarkdown("ephemeral resource", "ephemeral-resources", d.schemas().EphemeralResourceSchemas, name, options)
+}
+
+// ListResourceSchemaMarkdown returns the Markdown formatted schema of the
+// given list resource.
+func (d DocTemplateType) ListResourceSchemaMarkdown(name string, options ...string) (string, error) {
+	return d.schemaMarkdown("list resource", "list-resources", d.schemas().ListResourceSchemas, name, options)
+}
+
+// StateStoreSchemaMarkdown returns the Markdown formatted schema of the
+// given state store.
+func (d DocTemplateType) StateStoreSchemaMarkdown(name string, options ...string) (string, error) {
+	return d.schemaMarkdown("state store", "state-stores", d.schemas().StateStoreSchemas, name, options)
+}
+
+// ActionSchemaMarkdown returns the Markdown formatted schema of the given
+// action.
+func (d DocTemplateType) ActionSchemaMarkdown(name string, options ...string) (string, error) {
+	schema, ok := d.schemas().ActionSchemas[name]
+	if !ok {
+		return "", fmt.Errorf("action %q not found in provider schema", name)
+	}
+
+	opts, err := d.schemaOptionsWith("actions", name, options)
+	if err != nil {
+		return "", err
+	}
+
+	schemaBuffer := bytes.NewBuffer(nil)
+	err = schemamd.RenderAction(schema, schemaBuffer, opts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render action %q schema: %w", name, err)
+	}
+
+	return actionSchemaComment + "\n" + schemaBuffer.String(), nil
+}
+
+// FunctionSignatureMarkdown returns the Markdown formatted signature of the
+// given function.
+func (d DocTemplateType) FunctionSignatureMarkdown(name string) (string, error) {
+	signature, err := d.function(name)
+	if err != nil {
+		return "", err
+	}
+
+	funcSig, err := functionmd.RenderSignature(functionmd.CallName(providerShortName(d.ProviderName), name), signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function %q signature: %w", name, err)
+	}
+
+	return signatureComment + "\n" + funcSig, nil
+}
+
+// FunctionArgumentsMarkdown returns the Markdown formatted arguments,
+// including any variadic argument and their nested types, of the given
+// function.
+func (d DocTemplateType) FunctionArgumentsMarkdown(name string) (string, error) {
+	signature, err := d.function(name)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := functionArgumentsMarkdown(signature)
+	if err != nil {
+		return "", fmt.Errorf("function %q: %w", name, err)
+	}
+
+	return result, nil
+}
+
+// FunctionReturnTypeMarkdown returns the Markdown formatted return type,
+// including its nested types, of the given function.
+func (d DocTemplateType) FunctionReturnTypeMarkdown(name string) (string, error) {
+	signature, err := d.function(name)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := functionReturnTypeMarkdown(signature)
+	if err != nil {
+		return "", fmt.Errorf("function %q: %w", name, err)
+	}
+
+	return result, nil
+}
+
+func (d DocTemplateType) schemas() *tfjson.ProviderSchema {
+	if d.ProviderSchema == nil {
+		return &tfjson.ProviderSchema{}
+	}
+
+	return d.ProviderSchema
+}
+
+func (d DocTemplateType) function(name string) (*tfjson.FunctionSignature, error) {
+	signature, ok := d.schemas().Functions[name]
+	if !ok {
+		return nil, fmt.Errorf("function %q not found in provider schema", name)
+	}
+
+	return signature, nil
+}
+
+func (d DocTemplateType) schemaMarkdown(kind, dir string, schemas map[string]*tfjson.Schema, name string, options []string) (string, error) {
+	schema, ok := schemas[name]
+	if !ok {
+		return "", fmt.Errorf("%s %q not found in provider schema", kind, name)
+	}
+
+	opts, err := d.schemaOptionsWith(dir, name, options)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := renderSchemaMarkdown(schema, opts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render %s %q schema: %w", kind, name, err)
+	}
+
+	return result, nil
+}
+
+// schemaOptionsWith returns the schema options of the given name in the given
+// documentation directory, including its kind, schema order, overrides and
+// metadata, with the given template options applied.
+func (d DocTemplateType) schemaOptionsWith(dir, name string, options []string) (schemamd.Options, error) {
+	opts := schemaOptionsFor(d.schemaOptions, d.schemaOrder, d.schemaOverrides, d.schemaMetadata, dir, name)
+
+	return schemaOptionsWith(opts, options)
+}
+
+func renderSchemaMarkdown(schema *tfjson.Schema, opts schemamd.Options) (string, error) {
+	schemaBuffer := bytes.NewBuffer(nil)
+	err := schemamd.Render(schema, schemaBuffer, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return schemaComment + "\n" + schemaBuffer.String(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+
+	for key := range m {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func (t docTemplate) Render(providerDir string, out io.Writer, providerName, renderedProviderName string, providerSchema *tfjson.ProviderSchema, schemaOpts schemamd.Options, schemaOrder SchemaOrder, schemaOverrides SchemaOverrides, schemaMetadata SchemaMetadata, overview []OverviewEntry, frontMatter map[string]string) error {
+	s := string(t)
+	if s == "" {
+		return nil
+	}
+
+	return renderTemplate(providerDir, "docTemplate", s, out, newDocTemplateType(providerName, renderedProviderName, providerSchema, schemaOpts, schemaOrder, schemaOverrides, schemaMetadata, overview, frontMatter))
+}
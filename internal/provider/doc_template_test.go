@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestDocTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"test_b": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {
+							AttributeType: cty.String,
+							Description:   "Name of the thing",
+							Required:      true,
+						},
+					},
+				},
+			},
+			"test_a": {
+				Block: &tfjson.SchemaBlock{},
+			},
+		},
+		ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+			"test_b": {
+				Attributes: map[string]*tfjson.IdentityAttribute{
+					"name": {
+						IdentityType:      cty.String,
+						Description:       "Name of the thing",
+						RequiredForImport: true,
+					},
+				},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"echo": {
+				ReturnType: cty.String,
+				Parameters: []*tfjson.FunctionParameter{
+					{
+						Name:        "input",
+						Description: "Value to echo",
+						Type:        cty.String,
+					},
+				},
+			},
+			"pair": {
+				ReturnType: cty.Object(map[string]cty.Type{
+					"key": cty.String,
+				}),
+				Parameters: []*tfjson.FunctionParameter{
+					{
+						Name: "key",
+						Type: cty.String,
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		Template      string
+		Expected      string
+		ExpectedError string
+	}{
+		"provider names": {
+			Template: `{{ .ProviderName }} {{ .ProviderShortName }} {{ .RenderedProviderName }}`,
+			Expected: `terraform-provider-test test terraform-provider-test`,
+		},
+		"entity lists": {
+			Template: `{{ range .Resources }}{{ . }} {{ end }}{{ len .DataSources }} {{ index .Functions 0 }}`,
+			Expected: `test_a test_b 0 echo`,
+		},
+		"resource schema markdown": {
+			Template: `{{ .ResourceSchemaMarkdown "test_b" }}`,
+			Expected: schemaComment + "\n" + `## Schema
+
+### Required
+
+- ` + "`name`" + ` (String) Name of the thing
+
+`,
+		},
+		"resource schema markdown with options": {
+			Template: `{{ .ResourceSchemaMarkdown "test_b" "type-constraints" }}`,
+			Expected: schemaComment + "\n" + `## Schema
+
+### Required
+
+- ` + "`name`" + ` (String ` + "`string`" + `) Name of the thing
+
+`,
+		},
+		"resource identity schema markdown": {
+			Template: `{{ .ResourceIdentitySchemaMarkdown "test_b" }}`,
+			Expected: schemaComment + "\n" + `### Identity Schema
+
+#### Required
+
+- ` + "`name`" + ` (String) Name of the thing
+
+`,
+		},
+		"function signature markdown": {
+			Template: `{{ .FunctionSignatureMarkdown "echo" }}`,
+			Expected: signatureComment + "\n```text\nprovider::test::echo(input string) string\n```",
+		},
+		"function return type markdown": {
+			Template: `{{ .FunctionReturnTypeMarkdown "echo" }}`,
+			Expected: returnComment + "\nThe return type is `String`.",
+		},
+		"function return type markdown with nested types": {
+			Template: `{{ .FunctionReturnTypeMarkdown "pair" }}`,
+			Expected: returnComment + "\nThe return type is `Object` (see [below for nested type](#nestedreturntype)).\n\n" +
+				nestedComment + "\n<a id=\"nestedreturntype\"></a>\n### Nested Type for `return`\n\n- `key` (String)\n\n",
+		},
+		"missing resource": {
+			Template:      `{{ .ResourceSchemaMarkdown "test_c" }}`,
+			ExpectedError: `resource "test_c" not found in provider schema`,
+		},
+		"unknown schema option": {
+			Template:      `{{ .ResourceSchemaMarkdown "test_b" "attributes-last" }}`,
+			ExpectedError: `unknown schema option "attributes-last"`,
+		},
+		"missing resource identity": {
+			Template:      `{{ .ResourceIdentitySchemaMarkdown "test_a" }}`,
+			ExpectedError: `resource "test_a" identity schema not found in provider schema`,
+		},
+		"missing function": {
+			Template:      `{{ .FunctionArgumentsMarkdown "missing" }}`,
+			ExpectedError: `function "missing" not found in provider schema`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out strings.Builder
+
+			err := docTemplate(testCase.Template).Render("testdata/test-provider-dir", &out, "terraform-provider-test", "terraform-provider-test", providerSchema, schemamd.Options{}, nil, nil, nil, nil, nil)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, out.String()); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
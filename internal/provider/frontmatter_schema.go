@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+)
+
+// reservedFrontMatterKeys are the frontmatter keys written or checked by
+// tfplugindocs itself, which cannot be custom keys.
+var reservedFrontMatterKeys = []string{
+	"description",
+	"layout",
+	"page_title",
+	"subcategory",
+}
+
+// FrontMatterSchema represents a frontmatter schema file, which defines
+// custom YAML frontmatter keys in addition to the keys already known by
+// tfplugindocs (description, page_title, subcategory, etc.).
+//
+// For example:
+//
+//	keys:
+//	  owner:
+//	    required: true
+//	    value: platform-team
+//	  stability:
+//	    allowed_values: [stable, beta]
+//	directories:
+//	  guides:
+//	    owner:
+//	      required: false
+type FrontMatterSchema struct {
+	// Keys are the custom frontmatter keys for all documentation files.
+	Keys map[string]FrontMatterSchemaKey `yaml:"keys"`
+
+	// Directories are the custom frontmatter keys for documentation files
+	// in specific directories, keyed by a glob pattern of the directory
+	// relative to the documentation directory (e.g. "resources" or
+	// "cdktf/*/resources"). The provider index file is in the "." directory.
+	// Keys defined here replace keys of the same name defined in Keys. When
+	// multiple patterns match, they are applied in lexical order.
+	Directories map[string]map[string]FrontMatterSchemaKey `yaml:"directories"`
+}
+
+// FrontMatterSchemaKey represents a custom frontmatter key.
+type FrontMatterSchemaKey struct {
+	// AllowedValues, if set, are the only valid values of the key.
+	AllowedValues []string `yaml:"allowed_values"`
+
+	// Pattern, if set, is a regular expression the key value must match.
+	Pattern string `yaml:"pattern"`
+
+	// Required is whether the key must be present.
+	Required bool `yaml:"required"`
+
+	// Value is made available to templates via the FrontMatter field, so
+	// generated documentation can populate the key.
+	Value string `yaml:"value"`
+}
+
+func frontMatterSchemaFile(path string) (*FrontMatterSchema, error) {
+	log.Printf("[DEBUG] Reading Frontmatter Schema File %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading frontmatter schema file (%s): %w", path, err)
+	}
+
+	var schema FrontMatterSchema
+
+	// Unknown keys (e.g. a misspelled "allowed_value") are errors, instead of
+	// being silently ignored.
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+
+	err = dec.Decode(&schema)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing frontmatter schema file (%s): %w", path, err)
+	}
+
+	// Verify keys, patterns and values upfront so errors are not reported per
+	// file, and generated documentation satisfies the schema it is validated
+	// against.
+	for dir, keys := range schema.directoryKeys() {
+		for key, keySchema := range keys {
+			if slices.Contains(reservedFrontMatterKeys, key) {
+				return nil, fmt.Errorf("error parsing frontmatter schema file (%s): key %q in %q is reserved", path, key, dir)
+			}
+
+			var pattern *regexp.Regexp
+
+			if keySchema.Pattern != "" {
+				pattern, err = regexp.Compile(keySchema.Pattern)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing frontmatter schema file (%s): invalid pattern for key %q in %q: %w", path, key, dir, err)
+				}
+			}
+
+			if keySchema.Value == "" {
+				continue
+			}
+
+			if len(keySchema.AllowedValues) != 0 && !slices.Contains(keySchema.AllowedValues, keySchema.Value) {
+				return nil, fmt.Errorf("error parsing frontmatter schema file (%s): value %q for key %q in %q is not in the allowed values", path, keySchema.Value, key, dir)
+			}
+
+			if pattern != nil && !pattern.MatchString(keySchema.Value) {
+				return nil, fmt.Errorf("error parsing frontmatter schema file (%s): value %q for key %q in %q does not match the pattern %s", path, keySchema.Value, key, dir, pattern)
+			}
+		}
+	}
+
+	for pattern := range schema.Directories {
+		if !doublestar.ValidatePattern(pattern) {
+			return nil, fmt.Errorf("error parsing frontmatter schema file (%s): invalid directory pattern %q", path, pattern)
+		}
+	}
+
+	return &schema, nil
+}
+
+// directoryKeys returns all key definitions keyed by their directory
+// pattern, with the top-level keys under "**".
+func (s *FrontMatterSchema) directoryKeys() map[string]map[string]FrontMatterSchemaKey {
+	result := map[string]map[string]FrontMatterSchemaKey{
+		"**": s.Keys,
+	}
+
+	for pattern, keys := range s.Directories {
+		result[pattern] = keys
+	}
+
+	return result
+}
+
+// keys returns the custom frontmatter keys which apply to documentation
+// files in the given directory, relative to the documentation directory.
+func (s *FrontMatterSchema) keys(dir string) map[string]FrontMatterSchemaKey {
+	if s == nil {
+		return nil
+	}
+
+	result := make(map[string]FrontMatterSchemaKey, len(s.Keys))
+
+	for key, keySchema := range s.Keys {
+		result[key] = keySchema
+	}
+
+	patterns := make([]string, 0, len(s.Directories))
+	for pattern := range s.Directories {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	for _, pattern := range patterns {
+		if match, _ := doublestar.Match(pattern, path.Clean(dir)); !match {
+			continue
+		}
+
+		for key, keySchema := range s.Directories[pattern] {
+			result[key] = keySchema
+		}
+	}
+
+	return result
+}
+
+// FrontMatterOptions returns a copy of the given options including the
+// custom frontmatter keys which apply to the given directory.
+func (s *FrontMatterSchema) FrontMatterOptions(opts *check.FrontMatterOptions, dir string) *check.FrontMatterOptions {
+	keys := s.keys(dir)
+
+	if len(keys) == 0 {
+		return opts
+	}
+
+	result := *opts
+	result.Keys = make(map[string]*check.FrontMatterKeyOptions, len(keys))
+
+	for key, keySchema := range keys {
+		keyOptions := &check.FrontMatterKeyOptions{
+			AllowedValues: keySchema.AllowedValues,
+			Required:      keySchema.Required,
+		}
+
+		if keySchema.Pattern != "" {
+			// Patterns are verified when the file is read.
+			keyOptions.Pattern = regexp.MustCompile(keySchema.Pattern)
+		}
+
+		result.Keys[key] = keyOptions
+	}
+
+	return &result
+}
+
+// Values returns the configured values of the custom frontmatter keys which
+// apply to the given directory, for use in templates.
+func (s *FrontMatterSchema) Values(dir string) map[string]string {
+	result := make(map[string]string)
+
+	for key, keySchema := range s.keys(dir) {
+		if keySchema.Value == "" {
+			continue
+		}
+
+		result[key] = keySchema.Value
+	}
+
+	return result
+}
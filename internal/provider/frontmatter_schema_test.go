@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFrontMatterSchema_Values(t *testing.T) {
+	t.Parallel()
+
+	schema, err := frontMatterSchemaFile("testdata/frontmatter-schema.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		Dir      string
+		Expected map[string]string
+	}{
+		"index": {
+			Dir: "",
+			Expected: map[string]string{
+				"owner":     "platform-team",
+				"stability": "stable",
+			},
+		},
+		"resources": {
+			Dir: "resources/",
+			Expected: map[string]string{
+				"owner":     "platform-team",
+				"stability": "stable",
+			},
+		},
+		"guides": {
+			Dir: "guides",
+			Expected: map[string]string{
+				"stability": "stable",
+			},
+		},
+		"cdktf resources": {
+			Dir: "cdktf/typescript/resources",
+			Expected: map[string]string{
+				"owner":     "platform-team",
+				"stability": "beta",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schema.Values(testCase.Dir)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFrontMatterSchema_NilValues(t *testing.T) {
+	t.Parallel()
+
+	var schema *FrontMatterSchema
+
+	if got := schema.Values("resources"); len(got) != 0 {
+		t.Errorf("expected no values, got: %v", got)
+	}
+
+	if got := schema.FrontMatterOptions(RegistryFrontMatterOptions, "resources"); got != RegistryFrontMatterOptions {
+		t.Errorf("expected unchanged options, got: %v", got)
+	}
+}
+
+func TestFrontMatterSchemaFile_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Path          string
+		ExpectedError string
+	}{
+		"missing file": {
+			Path:          "testdata/missing-frontmatter-schema.yml",
+			ExpectedError: "error reading frontmatter schema file (testdata/missing-frontmatter-schema.yml)",
+		},
+		"unknown key": {
+			Path:          "testdata/frontmatter-schema-unknown-key.yml",
+			ExpectedError: "field allowed_value not found in type provider.FrontMatterSchemaKey",
+		},
+		"reserved key": {
+			Path:          "testdata/frontmatter-schema-reserved-key.yml",
+			ExpectedError: `key "subcategory" in "guides" is reserved`,
+		},
+		"value not in allowed values": {
+			Path:          "testdata/frontmatter-schema-disallowed-value.yml",
+			ExpectedError: `value "alpha" for key "stability" in "**" is not in the allowed values`,
+		},
+		"value not matching pattern": {
+			Path:          "testdata/frontmatter-schema-mismatched-value.yml",
+			ExpectedError: `value "latest" for key "min_provider_version" in "**" does not match the pattern ^\d+\.\d+\.\d+$`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := frontMatterSchemaFile(testCase.Path)
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
@@ -7,23 +7,18 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/cli"
-	"github.com/hashicorp/go-version"
-	install "github.com/hashicorp/hc-install"
-	"github.com/hashicorp/hc-install/checkpoint"
-	"github.com/hashicorp/hc-install/fs"
-	"github.com/hashicorp/hc-install/product"
-	"github.com/hashicorp/hc-install/releases"
-	"github.com/hashicorp/hc-install/src"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/cdktf"
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
 )
 
 var (
@@ -91,6 +86,13 @@ var (
 		"state-stores/%s.html.markdown",
 		"state-stores/%s.html.md",
 	}
+	websiteCdktfFile                 = "cdktf/%s/%s/%s.md.tmpl"
+	websiteCdktfFileStaticCandidates = []string{
+		"cdktf/%s/%s/%s.md",
+		"cdktf/%s/%s/%s.markdown",
+		"cdktf/%s/%s/%s.html.markdown",
+		"cdktf/%s/%s/%s.html.md",
+	}
 	websiteProviderFile                 = "index.md.tmpl"
 	websiteProviderFileStaticCandidates = []string{
 		"index.markdown",
@@ -113,11 +115,131 @@ var (
 	managedWebsiteFiles = []string{
 		"index.md",
 	}
+
+	// managedCdktfWebsiteSubDirectories are the subdirectories of each
+	// enabled CDKTF language directory (e.g. "cdktf/typescript") which are
+	// generated from the provider schema. The validate command checks the
+	// same subdirectories.
+	managedCdktfWebsiteSubDirectories = check.CdktfRegistrySubdirectories
 )
 
+type GeneratorOptions struct {
+	// CdktfLanguages is a comma separated list of CDKTF languages to
+	// generate language-specific documentation for.
+	CdktfLanguages string
+
+	// FrontMatterSchemaFile is the path to a frontmatter schema file, whose
+	// custom key values are made available to templates.
+	FrontMatterSchemaFile string
+
+	// SubcategoryRulesFile is the path to a subcategory rules file, which
+	// assigns frontmatter subcategories to generated pages.
+	SubcategoryRulesFile string
+
+	// AllowedResourceSubcategories is a comma separated list of allowed
+	// subcategories, which subcategory rules are validated against.
+	AllowedResourceSubcategories string
+
+	// AllowedResourceSubcategoriesFile is the path to a newline separated
+	// file of allowed subcategories, which subcategory rules are validated
+	// against.
+	AllowedResourceSubcategoriesFile string
+
+	// Overview includes an overview of all resources, data sources,
+	// functions, etc. grouped by subcategory in the provider index page.
+	Overview bool
+
+	// LinkReferences links references to resources, data sources and
+	// functions in generated documentation to their documentation pages.
+	LinkReferences bool
+
+	// DefaultIdentityImport generates an identity import example from the
+	// resource identity schema for resources without an identity import
+	// example file.
+	DefaultIdentityImport bool
+
+	// TypeConstraints writes the Terraform type constraint syntax of each
+	// attribute next to its type in generated documentation.
+	TypeConstraints bool
+
+	// EscapePlainDescriptions escapes Markdown characters (e.g. "*" and "_")
+	// in plain schema descriptions, so they are rendered as written in
+	// generated documentation.
+	EscapePlainDescriptions bool
+
+	// SchemaGroups is a comma separated list of additional attribute groups
+	// ("write-only" and "sensitive") in generated schema documentation.
+	SchemaGroups string
+
+	// SchemaBlocksLast writes blocks after attributes within each group of
+	// generated schema documentation.
+	SchemaBlocksLast bool
+
+	// SchemaOrderFile is the path to a schema order file, which lists the
+	// attributes and blocks written first within each group of generated
+	// schema documentation.
+	SchemaOrderFile string
+
+	// SchemaOverridesFile is the path to a schema overrides file, which
+	// overrides the generated documentation of attributes and blocks.
+	SchemaOverridesFile string
+
+	// SchemaMetadataFile is the path to a schema metadata JSON file, which
+	// describes default values and validation constraints of attributes and
+	// blocks in the generated documentation.
+	SchemaMetadataFile string
+
+	// IDDescription replaces the default description (e.g. "The ID of this
+	// resource.") of top-level `id` attributes without a description.
+	IDDescription string
+
+	// DisableDefaultID documents top-level `id` attributes without a
+	// description like every other attribute, instead of in the Read-Only
+	// group with a default description.
+	DisableDefaultID bool
+
+	// TerraformBinary is the path to a Terraform CLI binary, which is used to
+	// export the provider schema instead of finding or downloading one.
+	TerraformBinary string
+
+	// TerraformCacheDir is the directory of downloaded Terraform CLI
+	// binaries, by version.
+	TerraformCacheDir string
+
+	// Offline disables downloads of the Terraform CLI binary and Go modules.
+	Offline bool
+
+	// ProviderBuildFlags are space separated additional go build flags of the
+	// provider (e.g. "-tags=integration -trimpath").
+	ProviderBuildFlags string
+
+	// ProviderBuildEnv are comma separated additional go build environment
+	// variables of the provider (e.g. "CGO_ENABLED=0,GOFLAGS=-mod=vendor").
+	// Commas which do not start a new KEY=VALUE variable are part of the
+	// value.
+	ProviderBuildEnv string
+
+	// ProviderBinary is the path to a previously built provider binary, which
+	// is used to export the provider schema instead of building the provider.
+	ProviderBinary string
+
+	// ProviderSourceAddress is the provider source address (e.g.
+	// "registry.terraform.io/example/scaffolding").
+	ProviderSourceAddress string
+}
+
 type generator struct {
-	ignoreDeprecated bool
-	tfVersion        string
+	ignoreDeprecated      bool
+	linkReferences        bool
+	overview              bool
+	defaultIdentityImport bool
+	terraformOptions      TerraformOptions
+	providerBuildOptions  ProviderBuildOptions
+
+	schemaOptions   schemamd.Options
+	schemaOrder     SchemaOrder
+	schemaOverrides SchemaOverrides
+	schemaMetadata  SchemaMetadata
 
 	// providerDir is the absolute path to the root provider directory
 	providerDir string
@@ -130,6 +252,11 @@ type generator struct {
 	templatesDir         string
 	websiteTmpDir        string
 
+	cdktfLanguages []cdktf.Language
+
+	frontMatterSchema *FrontMatterSchema
+	subcategoryRules  *SubcategoryRules
+
 	ui cli.Ui
 }
 
@@ -141,7 +268,7 @@ func (g *generator) warnf(format string, a ...interface{}) {
 	g.ui.Warn(fmt.Sprintf(format, a...))
 }
 
-func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, renderedProviderName, renderedWebsiteDir, examplesDir, websiteTmpDir, templatesDir, tfVersion string, ignoreDeprecated bool) error {
+func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, renderedProviderName, renderedWebsiteDir, examplesDir, websiteTmpDir, templatesDir, tfVersion string, ignoreDeprecated bool, opts GeneratorOptions) error {
 	// Ensure provider directory is resolved absolute path
 	if providerDir == "" {
 		wd, err := os.Getwd()
@@ -173,8 +300,25 @@ func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, rendere
 	}
 
 	g := &generator{
-		ignoreDeprecated: ignoreDeprecated,
-		tfVersion:        tfVersion,
+		ignoreDeprecated:      ignoreDeprecated,
+		linkReferences:        opts.LinkReferences,
+		overview:              opts.Overview,
+		defaultIdentityImport: opts.DefaultIdentityImport,
+
+		terraformOptions: TerraformOptions{
+			Version:  tfVersion,
+			Binary:   opts.TerraformBinary,
+			CacheDir: opts.TerraformCacheDir,
+			Offline:  opts.Offline,
+		},
+
+		schemaOptions: schemamd.Options{
+			TypeConstraints:         opts.TypeConstraints,
+			EscapePlainDescriptions: opts.EscapePlainDescriptions,
+			BlocksLast:              opts.SchemaBlocksLast,
+			IDDescription:           opts.IDDescription,
+			DisableIDDefault:        opts.DisableDefaultID,
+		},
 
 		providerDir:          providerDir,
 		providerName:         providerName,
@@ -188,11 +332,137 @@ func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, rendere
 		ui: ui,
 	}
 
+	if err := g.loadCdktfLanguages(opts); err != nil {
+		return fmt.Errorf("error loading CDKTF languages: %w", err)
+	}
+
+	if o := opts.FrontMatterSchemaFile; o != "" {
+		frontMatterSchema, err := frontMatterSchemaFile(o)
+		if err != nil {
+			return fmt.Errorf("error loading frontmatter schema: %w", err)
+		}
+		g.frontMatterSchema = frontMatterSchema
+	}
+
+	if err := g.loadSubcategoryRules(opts); err != nil {
+		return fmt.Errorf("error loading subcategory rules: %w", err)
+	}
+
+	if err := g.loadSchemaOptions(opts); err != nil {
+		return fmt.Errorf("error loading schema options: %w", err)
+	}
+
+	if err := g.terraformOptions.validate(); err != nil {
+		return fmt.Errorf("error loading Terraform options: %w", err)
+	}
+
+	providerBuildOptions, err := newProviderBuildOptions(opts.ProviderBuildFlags, opts.ProviderBuildEnv, opts.ProviderBinary, opts.ProviderSourceAddress)
+	if err != nil {
+		return fmt.Errorf("error loading provider build options: %w", err)
+	}
+
+	if err := providerBuildOptions.validateProvidersSchema(providersSchemaPath); err != nil {
+		return fmt.Errorf("error loading provider build options: %w", err)
+	}
+	g.providerBuildOptions = providerBuildOptions
+
 	ctx := context.Background()
 
 	return g.Generate(ctx)
 }
 
+func (g *generator) loadCdktfLanguages(opts GeneratorOptions) error {
+	if opts.CdktfLanguages == "" {
+		return nil
+	}
+
+	for _, name := range strings.Split(opts.CdktfLanguages, ",") {
+		language, err := cdktf.LookupLanguage(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+
+		g.cdktfLanguages = append(g.cdktfLanguages, language)
+	}
+
+	return nil
+}
+
+func (g *generator) loadSubcategoryRules(opts GeneratorOptions) error {
+	if opts.SubcategoryRulesFile == "" {
+		return nil
+	}
+
+	subcategoryRules, err := subcategoryRulesFile(opts.SubcategoryRulesFile)
+	if err != nil {
+		return err
+	}
+
+	var allowedSubcategories []string
+
+	if o := opts.AllowedResourceSubcategories; o != "" {
+		allowedSubcategories = strings.Split(o, ",")
+	}
+
+	if o := opts.AllowedResourceSubcategoriesFile; o != "" {
+		allowedSubcategories, err = allowedSubcategoriesFile(o)
+		if err != nil {
+			return fmt.Errorf("error getting allowed resource subcategories: %w", err)
+		}
+	}
+
+	if err := subcategoryRules.Validate(allowedSubcategories); err != nil {
+		return err
+	}
+
+	g.subcategoryRules = subcategoryRules
+
+	return nil
+}
+
+func (g *generator) loadSchemaOptions(opts GeneratorOptions) error {
+	if o := opts.SchemaGroups; o != "" {
+		schemaOptions, err := schemaGroupsOptions(g.schemaOptions, o)
+		if err != nil {
+			return err
+		}
+		g.schemaOptions = schemaOptions
+	}
+
+	if o := opts.SchemaOrderFile; o != "" {
+		schemaOrder, err := schemaOrderFile(o)
+		if err != nil {
+			return err
+		}
+		g.schemaOrder = schemaOrder
+	}
+
+	if o := opts.SchemaOverridesFile; o != "" {
+		schemaOverrides, err := schemaOverridesFile(o)
+		if err != nil {
+			return err
+		}
+		g.schemaOverrides = schemaOverrides
+	}
+
+	if o := opts.SchemaMetadataFile; o != "" {
+		schemaMetadata, err := schemaMetadataFile(o)
+		if err != nil {
+			return err
+		}
+		g.schemaMetadata = schemaMetadata
+	}
+
+	return nil
+}
+
+// schemaOptionsFor returns the schema options for the given name in the given
+// documentation directory, including its kind, schema order, overrides and
+// metadata.
+func (g *generator) schemaOptionsFor(dir, name string) schemamd.Options {
+	return schemaOptionsFor(g.schemaOptions, g.schemaOrder, g.schemaOverrides, g.schemaMetadata, dir, name)
+}
+
 func (g *generator) Generate(ctx context.Context) error {
 	var err error
 
@@ -261,6 +531,10 @@ func (g *generator) Generate(ctx context.Context) error {
 		}
 	}
 
+	g.warnUnknownSchemaPaths(providerSchema, "schema order", g.schemaOrder)
+	g.warnUnknownSchemaPaths(providerSchema, "schema overrides", schemaPaths(g.schemaOverrides))
+	g.warnUnknownSchemaPaths(providerSchema, "schema metadata", schemaPaths(g.schemaMetadata))
+
 	g.infof("generating missing templates")
 	err = g.generateMissingTemplates(providerSchema)
 	if err != nil {
@@ -276,6 +550,15 @@ func (g *generator) Generate(ctx context.Context) error {
 	return nil
 }
 
+// warnUnknownSchemaPaths warns about names and attribute and block paths of
+// the given kind of schema file (e.g. "schema order") which do not match the
+// provider schema, as they are not applied to the generated documentation.
+func (g *generator) warnUnknownSchemaPaths(providerSchema *tfjson.ProviderSchema, kind string, paths map[string][]string) {
+	for _, message := range unknownSchemaPaths(providerSchema, paths) {
+		g.warnf("%s file: %s", kind, message)
+	}
+}
+
 // ProviderDocsDir returns the absolute path to the joined provider and
 // given website documentation directory, which defaults to "docs".
 func (g *generator) ProviderDocsDir() string {
@@ -509,7 +792,7 @@ func (g *generator) generateMissingListResourceTemplate(resourceName string) err
 	}
 
 	g.infof("generating new template for %q", resourceName)
-	err := writeFile(templatePath, string(defaultResourceTemplate))
+	err := writeFile(templatePath, string(defaultListResourceTemplate))
 	if err != nil {
 		return fmt.Errorf("unable to write template for %q: %w", resourceName, err)
 	}
@@ -545,7 +828,7 @@ func (g *generator) generateMissingStateStoreTemplate(stateStoreName string) err
 	}
 
 	g.infof("generating new template for %q", stateStoreName)
-	err := writeFile(templatePath, string(defaultResourceTemplate))
+	err := writeFile(templatePath, string(defaultStateStoreTemplate))
 	if err != nil {
 		return fmt.Errorf("unable to write template for %q: %w", stateStoreName, err)
 	}
@@ -553,6 +836,32 @@ func (g *generator) generateMissingStateStoreTemplate(stateStoreName string) err
 	return nil
 }
 
+func (g *generator) generateMissingCdktfTemplate(language cdktf.Language, subDirectory, resourceName string) error {
+	templatePath := fmt.Sprintf(websiteCdktfFile, language.Name, subDirectory, resourceShortName(resourceName, g.providerName))
+	templatePath = filepath.Join(g.TempTemplatesDir(), templatePath)
+	if fileExists(templatePath) {
+		g.infof("CDKTF %s %s %q template exists, skipping", language.Name, subDirectory, resourceName)
+		return nil
+	}
+
+	for _, candidate := range websiteCdktfFileStaticCandidates {
+		candidatePath := fmt.Sprintf(candidate, language.Name, subDirectory, resourceShortName(resourceName, g.providerName))
+		candidatePath = filepath.Join(g.TempTemplatesDir(), candidatePath)
+		if fileExists(candidatePath) {
+			g.infof("CDKTF %s %s %q static file exists, skipping", language.Name, subDirectory, resourceName)
+			return nil
+		}
+	}
+
+	g.infof("generating new CDKTF %s %s template for %q", language.Name, subDirectory, resourceName)
+	err := writeFile(templatePath, string(defaultCdktfResourceTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write CDKTF %s template for %q: %w", language.Name, resourceName, err)
+	}
+
+	return nil
+}
+
 func (g *generator) generateMissingProviderTemplate() error {
 	templatePath := filepath.Join(g.TempTemplatesDir(), websiteProviderFile)
 	if fileExists(templatePath) {
@@ -725,6 +1034,35 @@ func (g *generator) generateMissingTemplates(providerSchema *tfjson.ProviderSche
 		}
 	}
 
+	for _, language := range g.cdktfLanguages {
+		g.infof("generating missing CDKTF %s content", language.Name)
+
+		cdktfSchemas := []struct {
+			subDirectory string
+			keys         []string
+			schemas      map[string]*tfjson.Schema
+		}{
+			{"resources", resourceKeys, providerSchema.ResourceSchemas},
+			{"data-sources", dataSourceKeys, providerSchema.DataSourceSchemas},
+			{"ephemeral-resources", ephemeralKeys, providerSchema.EphemeralResourceSchemas},
+		}
+
+		for _, cdktfSchema := range cdktfSchemas {
+			for _, name := range cdktfSchema.keys {
+				schema := cdktfSchema.schemas[name]
+
+				if g.ignoreDeprecated && schema.Block.Deprecated {
+					continue
+				}
+
+				err := g.generateMissingCdktfTemplate(language, cdktfSchema.subDirectory, name)
+				if err != nil {
+					return fmt.Errorf("unable to generate CDKTF %s template for %q: %w", language.Name, name, err)
+				}
+			}
+		}
+	}
+
 	g.infof("generating missing provider content")
 	err := g.generateMissingProviderTemplate()
 	if err != nil {
@@ -764,10 +1102,28 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 		}
 	}
 
+	for _, language := range g.cdktfLanguages {
+		for _, subDirectory := range managedCdktfWebsiteSubDirectories {
+			dir := filepath.Join(g.ProviderDocsDir(), "cdktf", language.Name, subDirectory)
+			if _, err := os.Stat(dir); os.IsNotExist(err) {
+				continue
+			}
+
+			g.infof("removing directory: %q", filepath.Join("cdktf", language.Name, subDirectory))
+			err = os.RemoveAll(dir)
+			if err != nil {
+				return fmt.Errorf("unable to remove directory %q from rendered website directory: %w", dir, err)
+			}
+		}
+	}
+
 	shortName := providerShortName(g.providerName)
+	overview := providerOverview(providerSchema, g.providerName, g.subcategoryRules, g.ignoreDeprecated)
 
 	g.infof("rendering templated website to static markdown")
 
+	var renderedFiles []string
+
 	err = filepath.WalkDir(g.websiteTmpDir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("unable to walk path %q: %w", path, err)
@@ -822,7 +1178,54 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 		}
 		defer out.Close()
 
+		renderedFiles = append(renderedFiles, renderedPath)
+
 		g.infof("rendering %q", rel)
+
+		frontMatter := g.frontMatterSchema.Values(relDir)
+
+		if language, subDirectory, ok := g.cdktfTemplateDir(relDir); ok {
+			var resSchema *tfjson.Schema
+			var resName, typeName, exampleFilePrefix string
+
+			switch subDirectory {
+			case "resources":
+				resSchema, resName = resourceSchema(providerSchema.ResourceSchemas, shortName, relFile)
+				typeName, exampleFilePrefix = "Resource", "resource"
+			case "data-sources":
+				resSchema, resName = resourceSchema(providerSchema.DataSourceSchemas, shortName, relFile)
+				typeName, exampleFilePrefix = "Data Source", "data-source"
+			case "ephemeral-resources":
+				resSchema, resName = resourceSchema(providerSchema.EphemeralResourceSchemas, shortName, relFile)
+				typeName, exampleFilePrefix = "Ephemeral Resource", "ephemeral-resource"
+			}
+
+			if resSchema != nil {
+				exampleDir := filepath.Join(g.ProviderExamplesDir(), "cdktf", language.Name, subDirectory, resName)
+				exampleFilePath := filepath.Join(exampleDir, exampleFilePrefix+language.FileExtension)
+				exampleFilesPattern := filepath.Join(exampleDir, exampleFilePrefix+"*"+language.FileExtension)
+				exampleFiles, err := filepath.Glob(exampleFilesPattern)
+
+				if err != nil {
+					return fmt.Errorf("unable to glob example files with pattern %q: %w", exampleFilesPattern, err)
+				}
+
+				slices.Sort(exampleFiles)
+
+				tmpl := cdktfResourceTemplate(tmplData)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, typeName, language, exampleFilePath, exampleFiles, resSchema, g.schemaOptionsFor(subDirectory, resName), g.subcategoryRules.Subcategory(subDirectory, resName), frontMatter)
+				if err != nil {
+					return fmt.Errorf("unable to render CDKTF %s template %q: %w", language.Name, rel, err)
+				}
+				_, err = out.WriteString(render)
+				if err != nil {
+					return fmt.Errorf("unable to write rendered string: %w", err)
+				}
+				return nil
+			}
+			g.warnf("CDKTF %s entitled %q, or %q does not exist", subDirectory, shortName, resName)
+		}
+
 		switch relDir {
 		case "data-sources/":
 			resSchema, resName := resourceSchema(providerSchema.DataSourceSchemas, shortName, relFile)
@@ -839,7 +1242,7 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 				slices.Sort(exampleFiles)
 
 				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Data Source", exampleFilePath, exampleFiles, "", "", "", resSchema, nil)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Data Source", exampleFilePath, exampleFiles, "", "", "", resSchema, nil, false, g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render data source template %q: %w", rel, err)
 				}
@@ -868,9 +1271,12 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 				importFilePath := filepath.Join(g.ProviderExamplesDir(), "resources", resName, "import.sh")
 				importIDConfigFilePath := filepath.Join(g.ProviderExamplesDir(), "resources", resName, "import-by-string-id.tf")
 				importIdentityConfigFilePath := filepath.Join(g.ProviderExamplesDir(), "resources", resName, "import-by-identity.tf")
+				if resIdentitySchema == nil && fileExists(importIdentityConfigFilePath) {
+					g.warnf("resource %q does not support resource identity, skipping identity import example %q", resName, importIdentityConfigFilePath)
+				}
 
 				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Resource", exampleFilePath, exampleFiles, importIDConfigFilePath, importIdentityConfigFilePath, importFilePath, resSchema, resIdentitySchema)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Resource", exampleFilePath, exampleFiles, importIDConfigFilePath, importIdentityConfigFilePath, importFilePath, resSchema, resIdentitySchema, g.defaultIdentityImport, g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render resource template %q: %w", rel, err)
 				}
@@ -894,8 +1300,10 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 
 				slices.Sort(exampleFiles)
 
+				parameterExamples := g.functionParameterExamples(funcName, signature)
+
 				tmpl := functionTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, funcName, g.providerName, g.renderedProviderName, "function", exampleFilePath, exampleFiles, signature)
+				render, err := tmpl.Render(g.providerDir, funcName, g.providerName, g.renderedProviderName, "function", exampleFilePath, exampleFiles, parameterExamples, signature, g.subcategoryRules.Subcategory(relDir, funcName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render function template %q: %w", rel, err)
 				}
@@ -922,7 +1330,7 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 				slices.Sort(exampleFiles)
 
 				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Ephemeral Resource", exampleFilePath, exampleFiles, "", "", "", resSchema, nil)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Ephemeral Resource", exampleFilePath, exampleFiles, "", "", "", resSchema, nil, false, g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render ephemeral resource template %q: %w", rel, err)
 				}
@@ -947,8 +1355,17 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 
 				slices.Sort(exampleFiles)
 
+				triggerExampleFilesPattern := filepath.Join(g.ProviderExamplesDir(), "actions", resName, "trigger*.tf")
+				triggerExampleFiles, err := filepath.Glob(triggerExampleFilesPattern)
+
+				if err != nil {
+					return fmt.Errorf("unable to glob trigger example files with pattern %q: %w", triggerExampleFilesPattern, err)
+				}
+
+				slices.Sort(triggerExampleFiles)
+
 				tmpl := actionTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Action", exampleFilePath, exampleFiles, actionSchema)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Action", exampleFilePath, exampleFiles, triggerExampleFiles, actionSchema, g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render action template %q: %w", rel, err)
 				}
@@ -972,8 +1389,13 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 			slices.Sort(exampleFiles)
 
 			if resSchema != nil {
-				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "List Resource", exampleFilePath, exampleFiles, "", "", "", resSchema, nil)
+				var managedResourcePath string
+				if _, ok := providerSchema.ResourceSchemas[resName]; ok {
+					managedResourcePath = relativePath(relDir, fmt.Sprintf("resources/%s.md", resourceShortName(resName, g.providerName)))
+				}
+
+				tmpl := listResourceTemplate(tmplData)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "List Resource", exampleFilePath, exampleFiles, resSchema, managedResourcePath, providerSchema.ResourceIdentitySchemas[resName], g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render list resource template %q: %w", rel, err)
 				}
@@ -998,8 +1420,8 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 
 				slices.Sort(exampleFiles)
 
-				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "State Store", exampleFilePath, exampleFiles, "", "", "", resSchema, nil)
+				tmpl := stateStoreTemplate(tmplData)
+				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "State Store", exampleFilePath, exampleFiles, resSchema, g.schemaOptionsFor(relDir, resName), g.subcategoryRules.Subcategory(relDir, resName), frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render state store template %q: %w", rel, err)
 				}
@@ -1023,7 +1445,7 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 				slices.Sort(exampleFiles)
 
 				tmpl := providerTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, g.providerName, g.renderedProviderName, exampleFilePath, exampleFiles, providerSchema.ConfigSchema)
+				render, err := tmpl.Render(g.providerDir, g.providerName, g.renderedProviderName, exampleFilePath, exampleFiles, providerSchema.ConfigSchema, g.schemaOptionsFor("", "provider"), overview, g.overview, frontMatter)
 				if err != nil {
 					return fmt.Errorf("unable to render provider template %q: %w", rel, err)
 				}
@@ -1036,7 +1458,7 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 		}
 
 		tmpl := docTemplate(tmplData)
-		err = tmpl.Render(g.providerDir, out)
+		err = tmpl.Render(g.providerDir, out, g.providerName, g.renderedProviderName, providerSchema, g.schemaOptions, g.schemaOrder, g.schemaOverrides, g.schemaMetadata, overviewRelativeTo(overview, relDir), frontMatter)
 		if err != nil {
 			return fmt.Errorf("unable to render template %q: %w", rel, err)
 		}
@@ -1046,9 +1468,97 @@ func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) e
 		return fmt.Errorf("unable to render templated website to static markdown: %w", err)
 	}
 
+	if g.linkReferences {
+		g.infof("linking references in rendered website")
+		err = g.linkRenderedReferences(providerSchema, renderedFiles)
+		if err != nil {
+			return fmt.Errorf("unable to link references in rendered website: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// linkRenderedReferences links references to resources, data sources and
+// functions in the given rendered files to their documentation pages.
+func (g *generator) linkRenderedReferences(providerSchema *tfjson.ProviderSchema, renderedFiles []string) error {
+	linker := newReferenceLinker(providerSchema, g.providerName)
+
+	for _, renderedPath := range renderedFiles {
+		rel, err := filepath.Rel(g.ProviderDocsDir(), renderedPath)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w", g.ProviderDocsDir(), renderedPath, err)
+		}
+
+		content, err := os.ReadFile(renderedPath)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q: %w", rel, err)
+		}
+
+		linked := linker.Link(string(content), filepath.ToSlash(rel))
+		if linked == string(content) {
+			continue
+		}
+
+		err = os.WriteFile(renderedPath, []byte(linked), 0644)
+		if err != nil {
+			return fmt.Errorf("unable to write file %q: %w", rel, err)
+		}
+	}
+
 	return nil
 }
 
+// functionParameterExamples returns the parameter examples of the given
+// function, in parameter order. Parameter examples are located at
+// examples/functions/<function name>/parameters/<parameter name>.tfexpr.
+// They contain a bare Terraform expression, which is not a valid
+// configuration file, so they do not use the .tf extension.
+func (g *generator) functionParameterExamples(funcName string, signature *tfjson.FunctionSignature) []FunctionParameterExample {
+	parameters := signature.Parameters
+	if signature.VariadicParameter != nil {
+		parameters = append(slices.Clip(parameters), signature.VariadicParameter)
+	}
+
+	var result []FunctionParameterExample
+
+	for _, p := range parameters {
+		exampleFilePath := filepath.Join(g.ProviderExamplesDir(), "functions", funcName, "parameters", p.Name+".tfexpr")
+		if !fileExists(exampleFilePath) {
+			continue
+		}
+
+		result = append(result, FunctionParameterExample{
+			Name:        p.Name,
+			ExampleFile: exampleFilePath,
+		})
+	}
+
+	return result
+}
+
+// cdktfTemplateDir returns the CDKTF language and documentation subdirectory
+// for the given template directory (e.g. "cdktf/typescript/resources/"), if
+// the language is enabled and the subdirectory is generated from the schema.
+func (g *generator) cdktfTemplateDir(relDir string) (cdktf.Language, string, bool) {
+	parts := strings.Split(strings.TrimSuffix(relDir, "/"), "/")
+	if len(parts) != 3 || parts[0] != "cdktf" {
+		return cdktf.Language{}, "", false
+	}
+
+	if !slices.Contains(managedCdktfWebsiteSubDirectories, parts[2]) {
+		return cdktf.Language{}, "", false
+	}
+
+	for _, language := range g.cdktfLanguages {
+		if language.Name == parts[1] {
+			return language, parts[2], true
+		}
+	}
+
+	return cdktf.Language{}, "", false
+}
+
 func (g *generator) terraformProviderSchemaFromTerraform(ctx context.Context) (*tfjson.ProviderSchema, error) {
 	var err error
 
@@ -1060,58 +1570,29 @@ func (g *generator) terraformProviderSchemaFromTerraform(ctx context.Context) (*
 	}
 	defer os.RemoveAll(tmpDir)
 
-	g.infof("compiling provider %q", shortName)
-	providerPath := fmt.Sprintf("plugins/registry.terraform.io/hashicorp/%s/0.0.1/%s_%s", shortName, runtime.GOOS, runtime.GOARCH)
-	outFile := filepath.Join(tmpDir, providerPath, fmt.Sprintf("terraform-provider-%s", shortName))
-	switch runtime.GOOS {
-	case "windows":
-		outFile = outFile + ".exe"
-	}
-	buildCmd := exec.Command("go", "build", "-o", outFile)
-	buildCmd.Dir = g.providerDir
-	// TODO: constrain env here to make it a little safer?
-	_, err = runCmd(buildCmd)
-	if err != nil {
-		return nil, fmt.Errorf("unable to execute go build command: %w", err)
+	var tfBin string
+
+	// Offline, a missing Terraform binary cannot be downloaded, so it is
+	// found before building the provider, instead of failing after the build.
+	if g.terraformOptions.Offline {
+		tfBin, err = g.terraformOptions.ensureTerraform(ctx, NewLogger(g.ui))
+		if err != nil {
+			return nil, fmt.Errorf("unable to find or download Terraform binary: %w", err)
+		}
 	}
 
-	err = writeFile(filepath.Join(tmpDir, "provider.tf"), fmt.Sprintf(`
-provider %[1]q {
-}
-`, shortName))
+	err = g.providerBuildOptions.installProvider(tmpDir, g.providerDir, shortName, g.terraformOptions.Offline, NewLogger(g.ui))
 	if err != nil {
-		return nil, fmt.Errorf("unable to write provider.tf file: %w", err)
+		return nil, err
 	}
 
-	i := install.NewInstaller()
-	var sources []src.Source
-	if g.tfVersion != "" {
-		g.infof("downloading Terraform CLI binary version from releases.hashicorp.com: %s", g.tfVersion)
-		sources = []src.Source{
-			&releases.ExactVersion{
-				Product:    product.Terraform,
-				Version:    version.Must(version.NewVersion(g.tfVersion)),
-				InstallDir: tmpDir,
-			},
-		}
-	} else {
-		g.infof("using Terraform CLI binary from PATH if available, otherwise downloading latest Terraform CLI binary")
-		sources = []src.Source{
-			&fs.AnyVersion{
-				Product: &product.Terraform,
-			},
-			&checkpoint.LatestVersion{
-				InstallDir: tmpDir,
-				Product:    product.Terraform,
-			},
+	if tfBin == "" {
+		tfBin, err = g.terraformOptions.ensureTerraform(ctx, NewLogger(g.ui))
+		if err != nil {
+			return nil, fmt.Errorf("unable to find or download Terraform binary: %w", err)
 		}
 	}
 
-	tfBin, err := i.Ensure(context.Background(), sources)
-	if err != nil {
-		return nil, fmt.Errorf("unable to download Terraform binary: %w", err)
-	}
-
 	tf, err := tfexec.NewTerraform(tmpDir, tfBin)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new terraform exec instance: %w", err)
@@ -1129,15 +1610,7 @@ provider %[1]q {
 		return nil, fmt.Errorf("unable to retrieve provider schema from terraform exec: %w", err)
 	}
 
-	if ps, ok := schemas.Schemas[shortName]; ok {
-		return ps, nil
-	}
-
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
-		return ps, nil
-	}
-
-	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
+	return g.providerBuildOptions.providerSchema(schemas, shortName)
 }
 
 func (g *generator) terraformProviderSchemaFromFile() (*tfjson.ProviderSchema, error) {
@@ -1151,13 +1624,5 @@ func (g *generator) terraformProviderSchemaFromFile() (*tfjson.ProviderSchema, e
 		return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", err)
 	}
 
-	if ps, ok := schemas.Schemas[shortName]; ok {
-		return ps, nil
-	}
-
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
-		return ps, nil
-	}
-
-	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
+	return g.providerBuildOptions.providerSchema(schemas, shortName)
 }
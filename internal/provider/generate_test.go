@@ -14,7 +14,7 @@ func TestGenerator_terraformProviderSchemaFromFile(t *testing.T) {
 
 	g := &generator{
 		ignoreDeprecated: true,
-		tfVersion:        "1.0.0",
+		terraformOptions: TerraformOptions{Version: "1.0.0"},
 
 		providerDir:         "testdata/test-provider-dir",
 		providerName:        "terraform-provider-null",
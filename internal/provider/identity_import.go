@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const identityImportComment = "# generated by tfplugindocs from the resource identity schema, replace the placeholder values"
+
+// renderDefaultImportIdentityConfigMarkdown returns a Markdown formatted
+// import block for the given resource, setting the identity attributes
+// required for import to placeholder values of their type. It is used when
+// the resource has no identity import example file.
+func renderDefaultImportIdentityConfigMarkdown(name string, identitySchema *tfjson.IdentitySchema) string {
+	var required []string
+
+	for attrName, attr := range identitySchema.Attributes {
+		if attr.RequiredForImport {
+			required = append(required, attrName)
+		}
+	}
+
+	slices.Sort(required)
+
+	var b strings.Builder
+
+	b.WriteString("```terraform\n")
+	b.WriteString(identityImportComment + "\n")
+	b.WriteString("import {\n")
+
+	if len(required) == 0 {
+		b.WriteString("  to       = " + name + ".example\n")
+		b.WriteString("  identity = {}\n")
+		b.WriteString("}\n")
+		b.WriteString("```\n")
+
+		return b.String()
+	}
+
+	b.WriteString("  to = " + name + ".example\n")
+	b.WriteString("  identity = {\n")
+
+	width := 0
+	for _, attrName := range required {
+		width = max(width, len(attrName))
+	}
+
+	for _, attrName := range required {
+		b.WriteString("    " + attrName + strings.Repeat(" ", width-len(attrName)) + " = " + identityPlaceholder(attrName, identitySchema.Attributes[attrName].IdentityType) + "\n")
+	}
+
+	b.WriteString("  }\n")
+	b.WriteString("}\n")
+	b.WriteString("```\n")
+
+	return b.String()
+}
+
+// identityPlaceholder returns a placeholder value of the given identity
+// attribute type. Identity attributes are primitives or lists of primitives.
+func identityPlaceholder(name string, ty cty.Type) string {
+	switch {
+	case ty == cty.String:
+		return `"` + name + `"`
+	case ty == cty.Number:
+		return "0"
+	case ty == cty.Bool:
+		return "false"
+	case ty.IsListType(), ty.IsSetType():
+		return "[" + identityPlaceholder(name, ty.ElementType()) + "]"
+	default:
+		return "null"
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestRenderDefaultImportIdentityConfigMarkdown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		IdentitySchema *tfjson.IdentitySchema
+		Expected       string
+	}{
+		"required attributes": {
+			IdentitySchema: &tfjson.IdentitySchema{
+				Attributes: map[string]*tfjson.IdentityAttribute{
+					"name": {
+						IdentityType:      cty.String,
+						RequiredForImport: true,
+					},
+					"account_id": {
+						IdentityType:      cty.Number,
+						RequiredForImport: true,
+					},
+					"enabled": {
+						IdentityType:      cty.Bool,
+						RequiredForImport: true,
+					},
+					"zones": {
+						IdentityType:      cty.List(cty.String),
+						RequiredForImport: true,
+					},
+					"region": {
+						IdentityType:      cty.String,
+						OptionalForImport: true,
+					},
+				},
+			},
+			Expected: "```terraform\n" +
+				identityImportComment + "\n" +
+				"import {\n" +
+				"  to = test_thing.example\n" +
+				"  identity = {\n" +
+				"    account_id = 0\n" +
+				"    enabled    = false\n" +
+				"    name       = \"name\"\n" +
+				"    zones      = [\"zones\"]\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n",
+		},
+		"no required attributes": {
+			IdentitySchema: &tfjson.IdentitySchema{
+				Attributes: map[string]*tfjson.IdentityAttribute{
+					"region": {
+						IdentityType:      cty.String,
+						OptionalForImport: true,
+					},
+				},
+			},
+			Expected: "```terraform\n" +
+				identityImportComment + "\n" +
+				"import {\n" +
+				"  to       = test_thing.example\n" +
+				"  identity = {}\n" +
+				"}\n" +
+				"```\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := renderDefaultImportIdentityConfigMarkdown("test_thing", testCase.IdentitySchema)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
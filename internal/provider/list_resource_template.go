@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+type listResourceTemplate string
+
+type ListResourceTemplateType struct {
+	Type        string
+	Name        string
+	Description string
+	Subcategory string
+
+	HasExample   bool
+	HasExamples  bool
+	ExampleFile  string
+	ExampleFiles []string
+
+	ProviderName      string
+	ProviderShortName string
+
+	SchemaMarkdown string
+
+	HasManagedResource  bool
+	ManagedResourcePath string
+
+	HasIdentity            bool
+	IdentitySchemaMarkdown string
+
+	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
+}
+
+// Render renders the list resource template. The managed resource path is
+// the path of the documentation page of the managed resource listed by the
+// list resource, relative to the list resource page, if any. The identity
+// schema is the identity schema of the managed resource, if any.
+func (t listResourceTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, schema *tfjson.Schema, managedResourcePath string, identitySchema *tfjson.IdentitySchema, schemaOpts schemamd.Options, subcategory string, frontMatter map[string]string) (string, error) {
+	renderSchema := func(opts schemamd.Options) (string, error) {
+		schemaBuffer := bytes.NewBuffer(nil)
+		err := schemamd.RenderListResource(schema, schemaBuffer, opts)
+		if err != nil {
+			return "", err
+		}
+
+		return schemaComment + "\n" + schemaBuffer.String(), nil
+	}
+
+	schemaMarkdown, err := renderSchema(schemaOpts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render schema: %w", err)
+	}
+
+	identitySchemaMarkdown, err := renderIdentitySchemaMarkdown(identitySchema, schemaOpts)
+	if err != nil {
+		return "", err
+	}
+
+	s := string(t)
+	if s == "" {
+		return "", nil
+	}
+
+	return renderStringTemplate(providerDir, "listResourceTemplate", s, ListResourceTemplateType{
+		Type:        typeName,
+		Name:        name,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
+		Subcategory: subcategory,
+
+		HasExample:   exampleFile != "" && fileExists(exampleFile),
+		HasExamples:  len(exampleFiles) > 0,
+		ExampleFile:  exampleFile,
+		ExampleFiles: exampleFiles,
+
+		ProviderName:      providerName,
+		ProviderShortName: providerShortName(renderedProviderName),
+
+		SchemaMarkdown: schemaMarkdown,
+
+		HasManagedResource:  managedResourcePath != "",
+		ManagedResourcePath: managedResourcePath,
+
+		HasIdentity:            identitySchema != nil,
+		IdentitySchemaMarkdown: identitySchemaMarkdown,
+
+		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render:        renderSchema,
+		},
+	})
+}
+
+const defaultListResourceTemplate listResourceTemplate = `---
+` + frontmatterComment + `
+page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
+{{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+---
+
+# {{.Name}} ({{.Type}})
+
+{{ .Description | trimspace }}
+{{- if .HasManagedResource }}
+
+This list resource lists [` + "`" + `{{.Name}}` + "`" + `]({{.ManagedResourcePath}}) resources.
+{{- end }}
+
+{{ if .HasExamples -}}
+## Example Usage
+
+{{- range .ExampleFiles }}
+
+{{ tffile . }}
+{{- end }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+{{- if .HasIdentity }}
+
+## Results
+
+Each result contains the resource identity of a listed ` + "`" + `{{.Name}}` + "`" + ` resource, which can be used to import the resource.
+
+{{ .IdentitySchemaMarkdown | trimspace }}
+{{- end }}
+`
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestListResourceTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Description: "Lists things.",
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"filter": {
+					AttributeType: cty.String,
+					Description:   "Filter by name.",
+					Optional:      true,
+				},
+			},
+		},
+	}
+
+	identitySchema := &tfjson.IdentitySchema{
+		Attributes: map[string]*tfjson.IdentityAttribute{
+			"id": {
+				IdentityType:      cty.String,
+				Description:       "ID of the thing.",
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ExampleFiles        []string
+		ManagedResourcePath string
+		IdentitySchema      *tfjson.IdentitySchema
+		Expected            string
+	}{
+		"managed resource and identity": {
+			ExampleFiles:        []string{"list-resource.tfquery.hcl"},
+			ManagedResourcePath: "../resources/thing.md",
+			IdentitySchema:      identitySchema,
+			Expected: "true ../resources/thing.md\n" +
+				"true\n" +
+				"<!-- schema generated by tfplugindocs -->\n" +
+				"### Identity Schema\n\n" +
+				"#### Required\n\n" +
+				"- `id` (String) ID of the thing.\n\n\n" +
+				"<!-- schema generated by tfplugindocs -->\n" +
+				"## Schema\n\n" +
+				"The following arguments are supported in the `config` block of the `list` block.\n\n" +
+				"### Optional\n\n" +
+				"- `filter` (String) Filter by name.\n\n",
+		},
+		"no managed resource": {
+			Expected: "false \n" +
+				"false\n" +
+				"\n" +
+				"<!-- schema generated by tfplugindocs -->\n" +
+				"## Schema\n\n" +
+				"The following arguments are supported in the `config` block of the `list` block.\n\n" +
+				"### Optional\n\n" +
+				"- `filter` (String) Filter by name.\n\n",
+		},
+	}
+
+	template := listResourceTemplate(`{{ .HasManagedResource }} {{ .ManagedResourcePath }}
+{{ .HasIdentity }}
+{{ .IdentitySchemaMarkdown }}
+{{ .SchemaMarkdown }}`)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := template.Render("testdata/test-list-resource-dir", "test_thing", "terraform-provider-test", "terraform-provider-test", "List Resource", "", testCase.ExampleFiles, schema, testCase.ManagedResourcePath, testCase.IdentitySchema, schemamd.Options{}, "", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
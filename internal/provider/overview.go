@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/mdplain"
+)
+
+// OverviewEntry represents a resource, data source, function, etc. in the
+// provider overview.
+type OverviewEntry struct {
+	// Name is the full name of the entry (e.g. "scaffolding_example").
+	Name string
+
+	// Type is the entry type (e.g. "Resource" or "Data Source").
+	Type string
+
+	// Description is the first line of the plain text entry description.
+	Description string
+
+	// Subcategory is the subcategory assigned by the subcategory rules, if
+	// any.
+	Subcategory string
+
+	// Path is the path of the generated documentation page, relative to the
+	// page being rendered (e.g. "resources/example.md" in the provider index,
+	// or "../resources/example.md" in a guide).
+	Path string
+}
+
+// overviewTypes is the order of entry types in the overview.
+var overviewTypes = []string{
+	"Resource",
+	"Data Source",
+	"Ephemeral Resource",
+	"List Resource",
+	"Action",
+	"State Store",
+	"Function",
+}
+
+// providerOverview returns the overview entries of all resources, data
+// sources, functions, etc. in the provider schema, sorted by subcategory,
+// type and name, with paths relative to the documentation directory.
+// Deprecated entries are skipped if ignoreDeprecated is set, as their
+// documentation is not generated.
+func providerOverview(providerSchema *tfjson.ProviderSchema, providerName string, subcategoryRules *SubcategoryRules, ignoreDeprecated bool) []OverviewEntry {
+	if providerSchema == nil {
+		return nil
+	}
+
+	var result []OverviewEntry
+
+	addEntry := func(dir, typeName, name, description string, deprecated bool) {
+		if ignoreDeprecated && deprecated {
+			return
+		}
+
+		result = append(result, OverviewEntry{
+			Name:        name,
+			Type:        typeName,
+			Description: overviewDescription(description),
+			Subcategory: subcategoryRules.Subcategory(dir, name),
+			Path:        fmt.Sprintf("%s/%s.md", dir, resourceShortName(name, providerName)),
+		})
+	}
+
+	for name, schema := range providerSchema.ResourceSchemas {
+		addEntry("resources", "Resource", name, schemaDescription(schema), schemaDeprecated(schema))
+	}
+
+	for name, schema := range providerSchema.DataSourceSchemas {
+		addEntry("data-sources", "Data Source", name, schemaDescription(schema), schemaDeprecated(schema))
+	}
+
+	for name, schema := range providerSchema.EphemeralResourceSchemas {
+		addEntry("ephemeral-resources", "Ephemeral Resource", name, schemaDescription(schema), schemaDeprecated(schema))
+	}
+
+	for name, schema := range providerSchema.ListResourceSchemas {
+		addEntry("list-resources", "List Resource", name, schemaDescription(schema), schemaDeprecated(schema))
+	}
+
+	for name, schema := range providerSchema.ActionSchemas {
+		var description string
+		var deprecated bool
+		if schema != nil && schema.Block != nil {
+			description = schema.Block.Description
+			deprecated = schema.Block.Deprecated
+		}
+
+		addEntry("actions", "Action", name, description, deprecated)
+	}
+
+	for name, schema := range providerSchema.StateStoreSchemas {
+		addEntry("state-stores", "State Store", name, schemaDescription(schema), schemaDeprecated(schema))
+	}
+
+	for name, signature := range providerSchema.Functions {
+		if ignoreDeprecated && signature != nil && signature.DeprecationMessage != "" {
+			continue
+		}
+
+		var description string
+		if signature != nil {
+			description = signature.Summary
+
+			if description == "" {
+				description = signature.Description
+			}
+		}
+
+		result = append(result, OverviewEntry{
+			Name:        name,
+			Type:        "Function",
+			Description: overviewDescription(description),
+			Subcategory: subcategoryRules.Subcategory("functions", name),
+			Path:        fmt.Sprintf("functions/%s.md", name),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Subcategory != result[j].Subcategory {
+			// Entries without a subcategory are listed last.
+			if result[i].Subcategory == "" || result[j].Subcategory == "" {
+				return result[j].Subcategory == ""
+			}
+
+			return result[i].Subcategory < result[j].Subcategory
+		}
+
+		if result[i].Type != result[j].Type {
+			return overviewTypeIndex(result[i].Type) < overviewTypeIndex(result[j].Type)
+		}
+
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// overviewRelativeTo returns the given overview entries with paths relative
+// to the given page directory, which is relative to the documentation
+// directory (e.g. "guides").
+func overviewRelativeTo(entries []OverviewEntry, pageDir string) []OverviewEntry {
+	if len(entries) == 0 {
+		return entries
+	}
+
+	result := make([]OverviewEntry, len(entries))
+	for i, entry := range entries {
+		entry.Path = relativePath(pageDir, entry.Path)
+		result[i] = entry
+	}
+
+	return result
+}
+
+// renderOverviewMarkdown returns a Markdown formatted overview of the given
+// entries, with a table of entries per subcategory.
+func renderOverviewMarkdown(entries []OverviewEntry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString("## Overview\n")
+
+	hasSubcategories := false
+	for _, entry := range entries {
+		if entry.Subcategory != "" {
+			hasSubcategories = true
+			break
+		}
+	}
+
+	for i, entry := range entries {
+		if i == 0 || entry.Subcategory != entries[i-1].Subcategory {
+			if hasSubcategories {
+				subcategory := entry.Subcategory
+				if subcategory == "" {
+					subcategory = "Other"
+				}
+
+				b.WriteString("\n### " + subcategory + "\n")
+			}
+
+			b.WriteString("\n| Name | Type | Description |\n")
+			b.WriteString("|------|------|-------------|\n")
+		}
+
+		fmt.Fprintf(&b, "| [`%s`](%s) | %s | %s |\n", entry.Name, entry.Path, entry.Type, entry.Description)
+	}
+
+	return b.String()
+}
+
+func schemaDescription(schema *tfjson.Schema) string {
+	if schema == nil || schema.Block == nil {
+		return ""
+	}
+
+	return schema.Block.Description
+}
+
+func schemaDeprecated(schema *tfjson.Schema) bool {
+	return schema != nil && schema.Block != nil && schema.Block.Deprecated
+}
+
+// overviewDescription returns the first line of the given description as
+// plain text, escaped for use in a Markdown table.
+func overviewDescription(description string) string {
+	plain, err := mdplain.PlainMarkdown(description)
+	if err != nil {
+		plain = description
+	}
+
+	line, _, _ := strings.Cut(strings.TrimSpace(plain), "\n")
+
+	return strings.ReplaceAll(strings.TrimSpace(line), "|", `\|`)
+}
+
+func overviewTypeIndex(typeName string) int {
+	for i, t := range overviewTypes {
+		if t == typeName {
+			return i
+		}
+	}
+
+	return len(overviewTypes)
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestProviderOverview(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"test_instance": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Manages an **instance**.\n\nMore details.",
+				},
+			},
+			"test_network": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Manages a network | subnet.",
+				},
+			},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"test_instance": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Reads an instance.",
+				},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"parse_id": {
+				Summary:     "Parses an ID.",
+				Description: "Parses an ID into its parts.",
+			},
+		},
+	}
+
+	subcategoryRules := &SubcategoryRules{
+		Mapping: map[string]string{
+			"test_instance": "Compute",
+		},
+	}
+
+	expected := []OverviewEntry{
+		{
+			Name:        "test_instance",
+			Type:        "Resource",
+			Description: "Manages an instance.",
+			Subcategory: "Compute",
+			Path:        "resources/instance.md",
+		},
+		{
+			Name:        "test_instance",
+			Type:        "Data Source",
+			Description: "Reads an instance.",
+			Subcategory: "Compute",
+			Path:        "data-sources/instance.md",
+		},
+		{
+			Name:        "test_network",
+			Type:        "Resource",
+			Description: `Manages a network \| subnet.`,
+			Path:        "resources/network.md",
+		},
+		{
+			Name:        "parse_id",
+			Type:        "Function",
+			Description: "Parses an ID.",
+			Path:        "functions/parse_id.md",
+		},
+	}
+
+	got := providerOverview(providerSchema, "terraform-provider-test", subcategoryRules, false)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	expectedMarkdown := "## Overview\n" +
+		"\n### Compute\n" +
+		"\n| Name | Type | Description |\n" +
+		"|------|------|-------------|\n" +
+		"| [`test_instance`](resources/instance.md) | Resource | Manages an instance. |\n" +
+		"| [`test_instance`](data-sources/instance.md) | Data Source | Reads an instance. |\n" +
+		"\n### Other\n" +
+		"\n| Name | Type | Description |\n" +
+		"|------|------|-------------|\n" +
+		"| [`test_network`](resources/network.md) | Resource | Manages a network \\| subnet. |\n" +
+		"| [`parse_id`](functions/parse_id.md) | Function | Parses an ID. |\n"
+
+	if diff := cmp.Diff(expectedMarkdown, renderOverviewMarkdown(got)); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestProviderOverview_IgnoreDeprecated(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"test_instance": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Manages an instance.",
+				},
+			},
+			"test_legacy": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Manages a legacy instance.",
+					Deprecated:  true,
+				},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"parse_legacy_id": {
+				Summary:            "Parses a legacy ID.",
+				DeprecationMessage: "Use parse_id instead.",
+			},
+		},
+	}
+
+	expected := []OverviewEntry{
+		{
+			Name:        "test_instance",
+			Type:        "Resource",
+			Description: "Manages an instance.",
+			Path:        "resources/instance.md",
+		},
+	}
+
+	got := providerOverview(providerSchema, "terraform-provider-test", nil, true)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestOverviewRelativeTo(t *testing.T) {
+	t.Parallel()
+
+	entries := []OverviewEntry{
+		{
+			Name: "test_instance",
+			Type: "Resource",
+			Path: "resources/instance.md",
+		},
+		{
+			Name: "parse_id",
+			Type: "Function",
+			Path: "functions/parse_id.md",
+		},
+	}
+
+	expected := []OverviewEntry{
+		{
+			Name: "test_instance",
+			Type: "Resource",
+			Path: "../resources/instance.md",
+		},
+		{
+			Name: "parse_id",
+			Type: "Function",
+			Path: "../functions/parse_id.md",
+		},
+	}
+
+	got := overviewRelativeTo(entries, "guides/")
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	if entries[0].Path != "resources/instance.md" {
+		t.Errorf("expected entries to be unchanged, got path: %s", entries[0].Path)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// defaultProviderSourceHost and defaultProviderSourceNamespace are the
+// provider source address parts of providers without a source address.
+const (
+	defaultProviderSourceHost      = "registry.terraform.io"
+	defaultProviderSourceNamespace = "hashicorp"
+)
+
+// ProviderBuildOptions represents options for building the provider binary,
+// or using a previously built one, to export the provider schema.
+type ProviderBuildOptions struct {
+	// BuildFlags are additional go build flags (e.g. "-tags=integration" or
+	// "-trimpath").
+	BuildFlags []string
+
+	// BuildEnv are additional go build environment variables, as KEY=VALUE
+	// (e.g. "CGO_ENABLED=0").
+	BuildEnv []string
+
+	// Binary is the path to a previously built provider binary, which is used
+	// instead of building the provider.
+	Binary string
+
+	// SourceAddress is the provider source address (e.g.
+	// "registry.terraform.io/example/scaffolding" or "example/scaffolding").
+	// Defaults to "registry.terraform.io/hashicorp/<provider short name>".
+	SourceAddress string
+}
+
+// newProviderBuildOptions returns the provider build options of the given
+// space separated go build flags, comma separated go build environment
+// variables, provider binary and provider source address. Commas which do not
+// start a new KEY=VALUE variable are part of the value (e.g.
+// "GOFLAGS=-tags=integration,acc").
+func newProviderBuildOptions(buildFlags, buildEnv, binary, sourceAddress string) (ProviderBuildOptions, error) {
+	opts := ProviderBuildOptions{
+		Binary:        binary,
+		SourceAddress: sourceAddress,
+	}
+
+	flags, err := splitBuildFlags(buildFlags)
+	if err != nil {
+		return opts, fmt.Errorf("invalid go build flags %q: %w", buildFlags, err)
+	}
+	opts.BuildFlags = flags
+
+	for _, env := range strings.Split(buildEnv, ",") {
+		trimmed := strings.TrimSpace(env)
+		if trimmed == "" {
+			continue
+		}
+
+		if isBuildEnvVariable(trimmed) {
+			opts.BuildEnv = append(opts.BuildEnv, trimmed)
+			continue
+		}
+
+		if len(opts.BuildEnv) > 0 {
+			opts.BuildEnv[len(opts.BuildEnv)-1] += "," + env
+			continue
+		}
+
+		return opts, fmt.Errorf("invalid go build environment variable %q, expected KEY=VALUE", trimmed)
+	}
+
+	return opts, opts.validate()
+}
+
+// isBuildEnvVariable returns true if the given value starts with the name of
+// an environment variable followed by "=" (e.g. "CGO_ENABLED=0").
+func isBuildEnvVariable(env string) bool {
+	key, _, ok := strings.Cut(env, "=")
+	if !ok || key == "" {
+		return false
+	}
+
+	for i, r := range key {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validate returns an error if the options are invalid, so invalid options
+// fail before the provider is built.
+func (o ProviderBuildOptions) validate() error {
+	if o.Binary != "" && (len(o.BuildFlags) > 0 || len(o.BuildEnv) > 0) {
+		return errors.New("a provider binary and go build flags or environment variables cannot both be set")
+	}
+
+	if o.Binary != "" {
+		if _, err := os.Stat(o.Binary); err != nil {
+			return fmt.Errorf("unable to find provider binary %q: %w", o.Binary, err)
+		}
+	}
+
+	if o.SourceAddress != "" {
+		if _, _, _, err := parseProviderSourceAddress(o.SourceAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateProvidersSchema returns an error if a provider binary is set
+// together with the given providers schema JSON file, which is used instead
+// of running the provider, so the binary would be silently ignored.
+func (o ProviderBuildOptions) validateProvidersSchema(providersSchemaPath string) error {
+	if o.Binary != "" && providersSchemaPath != "" {
+		return errors.New("a provider binary and providers schema file cannot both be set")
+	}
+
+	return nil
+}
+
+// source returns the host, namespace and type of the provider source address,
+// defaulting to the given provider short name in the "hashicorp" namespace.
+func (o ProviderBuildOptions) source(shortName string) (string, string, string, error) {
+	if o.SourceAddress == "" {
+		return defaultProviderSourceHost, defaultProviderSourceNamespace, shortName, nil
+	}
+
+	return parseProviderSourceAddress(o.SourceAddress)
+}
+
+// installProvider builds the provider in the given provider directory, or
+// copies the previously built provider binary, into the "plugins" directory of
+// the given Terraform working directory, and writes its provider
+// configuration.
+func (o ProviderBuildOptions) installProvider(workDir, providerDir, shortName string, offline bool, l *Logger) error {
+	host, namespace, typeName, err := o.source(shortName)
+	if err != nil {
+		return err
+	}
+
+	providerPath := fmt.Sprintf("plugins/%s/%s/%s/0.0.1/%s_%s", host, namespace, typeName, runtime.GOOS, runtime.GOARCH)
+	outFile := filepath.Join(workDir, providerPath, fmt.Sprintf("terraform-provider-%s", typeName))
+	switch runtime.GOOS {
+	case "windows":
+		outFile = outFile + ".exe"
+	}
+
+	if o.Binary != "" {
+		l.infof("using provider binary %q", o.Binary)
+		err = copyFile(o.Binary, outFile, 0755)
+		if err != nil {
+			return fmt.Errorf("unable to copy provider binary %q: %w", o.Binary, err)
+		}
+	} else {
+		l.infof("compiling provider %q", shortName)
+		buildArgs := append([]string{"build", "-o", outFile}, o.BuildFlags...)
+		buildCmd := exec.Command("go", buildArgs...)
+		buildCmd.Dir = providerDir
+		buildCmd.Env = append(os.Environ(), o.BuildEnv...)
+		if offline {
+			// Fail instead of downloading missing Go modules.
+			buildCmd.Env = append(buildCmd.Env, "GOPROXY=off")
+		}
+		_, err = runCmd(buildCmd)
+		if err != nil {
+			return fmt.Errorf("unable to execute go build command: %w", err)
+		}
+	}
+
+	config := fmt.Sprintf(`
+provider %[1]q {
+}
+`, shortName)
+
+	if o.SourceAddress != "" {
+		config = fmt.Sprintf(`
+terraform {
+  required_providers {
+    %[1]s = {
+      source = "%[2]s/%[3]s/%[4]s"
+    }
+  }
+}
+`, shortName, host, namespace, typeName) + config
+	}
+
+	err = writeFile(filepath.Join(workDir, "provider.tf"), config)
+	if err != nil {
+		return fmt.Errorf("unable to write provider.tf file: %w", err)
+	}
+
+	return nil
+}
+
+// providerSchema returns the schema of the provider with the given short name
+// from the given provider schemas.
+func (o ProviderBuildOptions) providerSchema(schemas *tfjson.ProviderSchemas, shortName string) (*tfjson.ProviderSchema, error) {
+	if ps, ok := schemas.Schemas[shortName]; ok {
+		return ps, nil
+	}
+
+	host, namespace, typeName, err := o.source(shortName)
+	if err != nil {
+		return nil, err
+	}
+
+	if ps, ok := schemas.Schemas[host+"/"+namespace+"/"+typeName]; ok {
+		return ps, nil
+	}
+
+	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
+}
+
+// parseProviderSourceAddress returns the host, namespace and type of the given
+// provider source address, in the "[<HOSTNAME>/]<NAMESPACE>/<TYPE>" format.
+func parseProviderSourceAddress(address string) (string, string, string, error) {
+	parts := strings.Split(address, "/")
+
+	switch len(parts) {
+	case 2:
+		parts = append([]string{defaultProviderSourceHost}, parts...)
+	case 3:
+	default:
+		return "", "", "", fmt.Errorf("invalid provider source address %q, expected [<HOSTNAME>/]<NAMESPACE>/<TYPE>", address)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", fmt.Errorf("invalid provider source address %q, expected [<HOSTNAME>/]<NAMESPACE>/<TYPE>", address)
+		}
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// splitBuildFlags splits the given go build flags on spaces, except within
+// single or double quotes (e.g. -ldflags="-s -w"), like a shell.
+func splitBuildFlags(flags string) ([]string, error) {
+	var result []string
+	var current strings.Builder
+	var quote rune
+	inFlag := false
+
+	for _, r := range flags {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inFlag = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inFlag {
+				result = append(result, current.String())
+				current.Reset()
+				inFlag = false
+			}
+		default:
+			current.WriteRune(r)
+			inFlag = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+
+	if inFlag {
+		result = append(result, current.String())
+	}
+
+	return result, nil
+}
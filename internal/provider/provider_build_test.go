@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestNewProviderBuildOptions(t *testing.T) {
+	t.Parallel()
+
+	binary := filepath.Join(t.TempDir(), "terraform-provider-scaffolding")
+	err := os.WriteFile(binary, nil, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		BuildFlags    string
+		BuildEnv      string
+		Binary        string
+		SourceAddress string
+		Expected      ProviderBuildOptions
+		ExpectedError string
+	}{
+		"none": {},
+		"build flags": {
+			BuildFlags: ` -tags=integration  -ldflags="-s -w" -trimpath '-gcflags=all=-N -l'`,
+			Expected: ProviderBuildOptions{
+				BuildFlags: []string{"-tags=integration", "-ldflags=-s -w", "-trimpath", "-gcflags=all=-N -l"},
+			},
+		},
+		"build env": {
+			BuildEnv: "CGO_ENABLED=0, GOFLAGS=-mod=vendor",
+			Expected: ProviderBuildOptions{
+				BuildEnv: []string{"CGO_ENABLED=0", "GOFLAGS=-mod=vendor"},
+			},
+		},
+		"build env commas in values": {
+			BuildEnv: "GOFLAGS=-tags=integration,acc,CGO_ENABLED=0,GOPRIVATE=example.com/a,example.com/b",
+			Expected: ProviderBuildOptions{
+				BuildEnv: []string{"GOFLAGS=-tags=integration,acc", "CGO_ENABLED=0", "GOPRIVATE=example.com/a,example.com/b"},
+			},
+		},
+		"binary": {
+			Binary:        binary,
+			SourceAddress: "example/scaffolding",
+			Expected: ProviderBuildOptions{
+				Binary:        binary,
+				SourceAddress: "example/scaffolding",
+			},
+		},
+		"unterminated quote": {
+			BuildFlags:    `-ldflags="-s -w`,
+			ExpectedError: "unterminated \" quote",
+		},
+		"invalid build env": {
+			BuildEnv:      "CGO_ENABLED",
+			ExpectedError: `invalid go build environment variable "CGO_ENABLED"`,
+		},
+		"binary and build flags": {
+			BuildFlags:    "-tags=integration",
+			Binary:        binary,
+			ExpectedError: "a provider binary and go build flags or environment variables cannot both be set",
+		},
+		"invalid build env name": {
+			BuildEnv:      "-tags=integration",
+			ExpectedError: `invalid go build environment variable "-tags=integration"`,
+		},
+		"binary not found": {
+			Binary:        filepath.Join(t.TempDir(), "missing"),
+			ExpectedError: "unable to find provider binary",
+		},
+		"invalid source address": {
+			SourceAddress: "scaffolding",
+			ExpectedError: `invalid provider source address "scaffolding"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newProviderBuildOptions(testCase.BuildFlags, testCase.BuildEnv, testCase.Binary, testCase.SourceAddress)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderBuildOptions_validateProvidersSchema(t *testing.T) {
+	t.Parallel()
+
+	opts := ProviderBuildOptions{Binary: "terraform-provider-scaffolding"}
+
+	err := opts.validateProvidersSchema("schema.json")
+	if err == nil || err.Error() != "a provider binary and providers schema file cannot both be set" {
+		t.Fatalf("expected providers schema error, got: %v", err)
+	}
+
+	err = opts.validateProvidersSchema("")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = ProviderBuildOptions{}.validateProvidersSchema("schema.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestProviderBuildOptions_installProvider_Binary(t *testing.T) {
+	t.Parallel()
+
+	binary := filepath.Join(t.TempDir(), "terraform-provider-scaffolding")
+	err := os.WriteFile(binary, []byte("provider"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := ProviderBuildOptions{
+		Binary:        binary,
+		SourceAddress: "example.com/example/scaffolding",
+	}
+
+	workDir := t.TempDir()
+
+	err = opts.installProvider(workDir, "", "scaffolding", true, NewLogger(cli.NewMockUi()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	outFile := filepath.Join(workDir, "plugins", "example.com", "example", "scaffolding", "0.0.1", runtime.GOOS+"_"+runtime.GOARCH, "terraform-provider-scaffolding")
+	if runtime.GOOS == "windows" {
+		outFile += ".exe"
+	}
+
+	got, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff("provider", string(got)); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	config, err := os.ReadFile(filepath.Join(workDir, "provider.tf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedConfig := `
+terraform {
+  required_providers {
+    scaffolding = {
+      source = "example.com/example/scaffolding"
+    }
+  }
+}
+
+provider "scaffolding" {
+}
+`
+	if diff := cmp.Diff(expectedConfig, string(config)); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestProviderBuildOptions_installProvider_BuildFlags(t *testing.T) {
+	t.Parallel()
+
+	// The provider only builds with the "docs" build tag.
+	providerDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(providerDir, "go.mod"), []byte("module example.com/terraform-provider-scaffolding\n\ngo 1.21\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(providerDir, "main.go"), []byte("//go:build docs\n\npackage main\n\nfunc main() {}\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts, err := newProviderBuildOptions("-tags=docs -trimpath", "CGO_ENABLED=0", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	workDir := t.TempDir()
+
+	err = opts.installProvider(workDir, providerDir, "scaffolding", false, NewLogger(cli.NewMockUi()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	outFile := filepath.Join(workDir, "plugins", "registry.terraform.io", "hashicorp", "scaffolding", "0.0.1", runtime.GOOS+"_"+runtime.GOARCH, "terraform-provider-scaffolding")
+	if runtime.GOOS == "windows" {
+		outFile += ".exe"
+	}
+
+	if _, err := os.Stat(outFile); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config, err := os.ReadFile(filepath.Join(workDir, "provider.tf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedConfig := `
+provider "scaffolding" {
+}
+`
+	if diff := cmp.Diff(expectedConfig, string(config)); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestProviderBuildOptions_providerSchema(t *testing.T) {
+	t.Parallel()
+
+	example := &tfjson.ProviderSchema{}
+	hashicorp := &tfjson.ProviderSchema{}
+
+	schemas := &tfjson.ProviderSchemas{
+		Schemas: map[string]*tfjson.ProviderSchema{
+			"registry.terraform.io/example/scaffolding":   example,
+			"registry.terraform.io/hashicorp/scaffolding": hashicorp,
+		},
+	}
+
+	testCases := map[string]struct {
+		Options       ProviderBuildOptions
+		Expected      *tfjson.ProviderSchema
+		ExpectedError string
+	}{
+		"default": {
+			Expected: hashicorp,
+		},
+		"source address": {
+			Options:  ProviderBuildOptions{SourceAddress: "example/scaffolding"},
+			Expected: example,
+		},
+		"source address - not found": {
+			Options:       ProviderBuildOptions{SourceAddress: "example.com/example/scaffolding"},
+			ExpectedError: `unable to find schema in JSON for provider "scaffolding"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.Options.providerSchema(schemas, "scaffolding")
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected schema of %q", testCase.Options.SourceAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// codeSpanRegexp matches single backtick Markdown code spans.
+var codeSpanRegexp = regexp.MustCompile("`([^`\n]+)`")
+
+// referenceLinker links references to resources, data sources and functions
+// in generated documentation to their documentation pages.
+type referenceLinker struct {
+	// targets are the documentation page paths, relative to the
+	// documentation directory, keyed by code span content.
+	targets map[string]string
+}
+
+func newReferenceLinker(providerSchema *tfjson.ProviderSchema, providerName string) *referenceLinker {
+	linker := &referenceLinker{
+		targets: make(map[string]string),
+	}
+
+	if providerSchema == nil {
+		return linker
+	}
+
+	// Data sources are added first, so resources of the same name take
+	// precedence.
+	for name := range providerSchema.DataSourceSchemas {
+		linker.targets[name] = path.Join("data-sources", resourceShortName(name, providerName)+".md")
+	}
+
+	for name := range providerSchema.ResourceSchemas {
+		linker.targets[name] = path.Join("resources", resourceShortName(name, providerName)+".md")
+	}
+
+	shortName := providerShortName(providerName)
+
+	for name := range providerSchema.Functions {
+		target := path.Join("functions", name+".md")
+
+		linker.targets["provider::"+shortName+"::"+name] = target
+		linker.targets[name+"()"] = target
+	}
+
+	return linker
+}
+
+// Link returns the given Markdown content with code spans referencing a
+// known resource, data source or function replaced by relative links. The
+// page path is relative to the documentation directory. YAML frontmatter,
+// fenced code blocks, existing links and references to the page itself are
+// not changed.
+func (l *referenceLinker) Link(content, pagePath string) string {
+	if len(l.targets) == 0 {
+		return content
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	pageDir := path.Dir(pagePath)
+
+	inFrontMatter := false
+	inCodeBlock := false
+	codeFence := ""
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if i == 0 && trimmed == "---" {
+			inFrontMatter = true
+			continue
+		}
+
+		if inFrontMatter {
+			if trimmed == "---" {
+				inFrontMatter = false
+			}
+			continue
+		}
+
+		if inCodeBlock {
+			if strings.HasPrefix(trimmed, codeFence) {
+				inCodeBlock = false
+			}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCodeBlock = true
+			codeFence = trimmed[:3]
+			continue
+		}
+
+		lines[i] = l.linkLine(line, pagePath, pageDir)
+	}
+
+	return strings.Join(lines, "")
+}
+
+func (l *referenceLinker) linkLine(line, pagePath, pageDir string) string {
+	matches := codeSpanRegexp.FindAllStringSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line
+	}
+
+	var b strings.Builder
+	last := 0
+
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		target, ok := l.targets[line[match[2]:match[3]]]
+
+		// Skip unknown references, references to the page itself and code
+		// spans which are already link text.
+		if !ok || target == pagePath || (start > 0 && line[start-1] == '[') || (end < len(line) && line[end] == ']') {
+			continue
+		}
+
+		rel := relativePath(pageDir, target)
+
+		b.WriteString(line[last:start])
+		b.WriteString("[" + line[start:end] + "](" + rel + ")")
+		last = end
+	}
+
+	b.WriteString(line[last:])
+
+	return b.String()
+}
+
+// relativePath returns the slash separated path of target relative to dir,
+// where both are relative to the same root directory.
+func relativePath(dir, target string) string {
+	dirParts := strings.Split(path.Clean(dir), "/")
+	targetParts := strings.Split(path.Clean(target), "/")
+
+	if dirParts[0] == "." {
+		dirParts = nil
+	}
+
+	common := 0
+	for common < len(dirParts) && common < len(targetParts)-1 && dirParts[common] == targetParts[common] {
+		common++
+	}
+
+	parts := make([]string, 0, len(dirParts)-common+len(targetParts)-common)
+	for range dirParts[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, targetParts[common:]...)
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestReferenceLinker_Link(t *testing.T) {
+	t.Parallel()
+
+	linker := newReferenceLinker(&tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"acme_network": {},
+			"acme_subnet":  {},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"acme_network": {},
+			"acme_zones":   {},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"parse_id": {},
+		},
+	}, "terraform-provider-acme")
+
+	testCases := map[string]struct {
+		Content  string
+		PagePath string
+		Expected string
+	}{
+		"resource and data source references": {
+			Content:  "Attaches to an `acme_network` in one of the `acme_zones`.\n",
+			PagePath: "resources/subnet.md",
+			Expected: "Attaches to an [`acme_network`](network.md) in one of the [`acme_zones`](../data-sources/zones.md).\n",
+		},
+		"function references": {
+			Content:  "Use `provider::acme::parse_id` or `parse_id()` with `parse_id`.\n",
+			PagePath: "guides/ids.md",
+			Expected: "Use [`provider::acme::parse_id`](../functions/parse_id.md) or [`parse_id()`](../functions/parse_id.md) with `parse_id`.\n",
+		},
+		"index page": {
+			Content:  "See `acme_subnet`.",
+			PagePath: "index.md",
+			Expected: "See [`acme_subnet`](resources/subnet.md).",
+		},
+		"self reference": {
+			Content:  "The `acme_subnet` resource.\n",
+			PagePath: "resources/subnet.md",
+			Expected: "The `acme_subnet` resource.\n",
+		},
+		"existing link": {
+			Content:  "See [`acme_subnet`](https://example.com).\n",
+			PagePath: "index.md",
+			Expected: "See [`acme_subnet`](https://example.com).\n",
+		},
+		"frontmatter and code blocks": {
+			Content: "---\n" +
+				"description: |-\n" +
+				"  Uses `acme_subnet`.\n" +
+				"---\n" +
+				"\n" +
+				"```terraform\n" +
+				"# `acme_subnet`\n" +
+				"```\n" +
+				"\n" +
+				"Uses `acme_subnet`.\n",
+			PagePath: "resources/network.md",
+			Expected: "---\n" +
+				"description: |-\n" +
+				"  Uses `acme_subnet`.\n" +
+				"---\n" +
+				"\n" +
+				"```terraform\n" +
+				"# `acme_subnet`\n" +
+				"```\n" +
+				"\n" +
+				"Uses [`acme_subnet`](subnet.md).\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := linker.Link(testCase.Content, testCase.PagePath)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRelativePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Dir      string
+		Target   string
+		Expected string
+	}{
+		"root":        {Dir: ".", Target: "resources/example.md", Expected: "resources/example.md"},
+		"same dir":    {Dir: "resources", Target: "resources/example.md", Expected: "example.md"},
+		"sibling dir": {Dir: "data-sources", Target: "resources/example.md", Expected: "../resources/example.md"},
+		"nested dir":  {Dir: "cdktf/python/resources", Target: "resources/example.md", Expected: "../../../resources/example.md"},
+		"same name":   {Dir: "resources/resources", Target: "resources/example.md", Expected: "../example.md"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := relativePath(testCase.Dir, testCase.Target)
+
+			if got != testCase.Expected {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.Expected, got))
+			}
+		})
+	}
+}
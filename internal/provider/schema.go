@@ -7,22 +7,12 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
-	"path/filepath"
-	"runtime"
-
-	"github.com/hashicorp/go-version"
-	install "github.com/hashicorp/hc-install"
-	"github.com/hashicorp/hc-install/checkpoint"
-	"github.com/hashicorp/hc-install/fs"
-	"github.com/hashicorp/hc-install/product"
-	"github.com/hashicorp/hc-install/releases"
-	"github.com/hashicorp/hc-install/src"
+
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
-func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, providerDir, tfVersion string, l *Logger) (*tfjson.ProviderSchema, error) {
+func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, providerDir string, buildOpts ProviderBuildOptions, tfOpts TerraformOptions, l *Logger) (*tfjson.ProviderSchema, error) {
 	var err error
 
 	shortName := providerShortName(providerName)
@@ -33,58 +23,29 @@ func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, pro
 	}
 	defer os.RemoveAll(tmpDir)
 
-	l.infof("compiling provider %q", shortName)
-	providerPath := fmt.Sprintf("plugins/registry.terraform.io/hashicorp/%s/0.0.1/%s_%s", shortName, runtime.GOOS, runtime.GOARCH)
-	outFile := filepath.Join(tmpDir, providerPath, fmt.Sprintf("terraform-provider-%s", shortName))
-	switch runtime.GOOS {
-	case "windows":
-		outFile = outFile + ".exe"
-	}
-	buildCmd := exec.Command("go", "build", "-o", outFile)
-	buildCmd.Dir = providerDir
-	// TODO: constrain env here to make it a little safer?
-	_, err = runCmd(buildCmd)
-	if err != nil {
-		return nil, fmt.Errorf("unable to execute go build command: %w", err)
+	var tfBin string
+
+	// Offline, a missing Terraform binary cannot be downloaded, so it is
+	// found before building the provider, instead of failing after the build.
+	if tfOpts.Offline {
+		tfBin, err = tfOpts.ensureTerraform(ctx, l)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find or download Terraform binary: %w", err)
+		}
 	}
 
-	err = writeFile(filepath.Join(tmpDir, "provider.tf"), fmt.Sprintf(`
-provider %[1]q {
-}
-`, shortName))
+	err = buildOpts.installProvider(tmpDir, providerDir, shortName, tfOpts.Offline, l)
 	if err != nil {
-		return nil, fmt.Errorf("unable to write provider.tf file: %w", err)
+		return nil, err
 	}
 
-	i := install.NewInstaller()
-	var sources []src.Source
-	if tfVersion != "" {
-		l.infof("downloading Terraform CLI binary version from releases.hashicorp.com: %s", tfVersion)
-		sources = []src.Source{
-			&releases.ExactVersion{
-				Product:    product.Terraform,
-				Version:    version.Must(version.NewVersion(tfVersion)),
-				InstallDir: tmpDir,
-			},
-		}
-	} else {
-		l.infof("using Terraform CLI binary from PATH if available, otherwise downloading latest Terraform CLI binary")
-		sources = []src.Source{
-			&fs.AnyVersion{
-				Product: &product.Terraform,
-			},
-			&checkpoint.LatestVersion{
-				InstallDir: tmpDir,
-				Product:    product.Terraform,
-			},
+	if tfBin == "" {
+		tfBin, err = tfOpts.ensureTerraform(ctx, l)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find or download Terraform binary: %w", err)
 		}
 	}
 
-	tfBin, err := i.Ensure(context.Background(), sources)
-	if err != nil {
-		return nil, fmt.Errorf("unable to download Terraform binary: %w", err)
-	}
-
 	tf, err := tfexec.NewTerraform(tmpDir, tfBin)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new terraform exec instance: %w", err)
@@ -102,18 +63,10 @@ provider %[1]q {
 		return nil, fmt.Errorf("unable to retrieve provider schema from terraform exec: %w", err)
 	}
 
-	if ps, ok := schemas.Schemas[shortName]; ok {
-		return ps, nil
-	}
-
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
-		return ps, nil
-	}
-
-	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
+	return buildOpts.providerSchema(schemas, shortName)
 }
 
-func TerraformProviderSchemaFromFile(providerName, providersSchemaPath string, l *Logger) (*tfjson.ProviderSchema, error) {
+func TerraformProviderSchemaFromFile(providerName, providersSchemaPath string, buildOpts ProviderBuildOptions, l *Logger) (*tfjson.ProviderSchema, error) {
 	var err error
 
 	shortName := providerShortName(providerName)
@@ -124,13 +77,5 @@ func TerraformProviderSchemaFromFile(providerName, providersSchemaPath string, l
 		return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", err)
 	}
 
-	if ps, ok := schemas.Schemas[shortName]; ok {
-		return ps, nil
-	}
-
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
-		return ps, nil
-	}
-
-	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
+	return buildOpts.providerSchema(schemas, shortName)
 }
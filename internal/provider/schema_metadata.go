@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+// SchemaMetadata represents a schema metadata file, which describes default
+// values and validation constraints of attributes and blocks by path, per
+// resource, data source, etc., which are known to the provider but not part of
+// the provider schema. It also declares the state store attributes which
+// configure state locking and workspaces. Names can be qualified with the documentation directory
+// (e.g. "data-sources/scaffolding_thing") to only apply to that directory. The
+// provider schema uses the "provider" name.
+//
+// For example:
+//
+//	{
+//	  "scaffolding_example": {
+//	    "name": {
+//	      "min_length": 1,
+//	      "max_length": 63,
+//	      "pattern": "^[a-z][a-z0-9-]*$"
+//	    },
+//	    "mode": {
+//	      "default": "standard",
+//	      "one_of": ["standard", "advanced"],
+//	      "conflicts_with": ["settings"]
+//	    }
+//	  }
+//	}
+type SchemaMetadata map[string]map[string]schemamd.Metadata
+
+func schemaMetadataFile(path string) (SchemaMetadata, error) {
+	log.Printf("[DEBUG] Reading Schema Metadata File %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema metadata file (%s): %w", path, err)
+	}
+
+	var metadata SchemaMetadata
+
+	// Numbers are kept as written, instead of converted to float64. Unknown
+	// keys (e.g. a misspelled "one_off") are errors, instead of being silently
+	// ignored.
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+
+	err = dec.Decode(&metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema metadata file (%s): %w", path, err)
+	}
+
+	return metadata, nil
+}
+
+// Options returns the given schema options with the metadata of the given
+// name in the given documentation directory (e.g. "resources"), if the name
+// is in the schema metadata file.
+func (m SchemaMetadata) Options(opts schemamd.Options, dir, name string) schemamd.Options {
+	if metadata, ok := lookup(m, dir, name); ok {
+		opts.Metadata = metadata
+	}
+
+	return opts
+}
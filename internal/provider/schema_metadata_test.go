@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestSchemaMetadata_Options(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := schemaMetadataFile("testdata/schema-metadata.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	minLength, maxLength := 1, 63
+
+	testCases := map[string]struct {
+		Dir      string
+		Name     string
+		Expected schemamd.Options
+	}{
+		"provider": {
+			Name: "provider",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Metadata: map[string]schemamd.Metadata{
+					"endpoint": {
+						Default: "https://api.example.com",
+					},
+				},
+			},
+		},
+		"name": {
+			Dir:  "resources/",
+			Name: "scaffolding_example",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Metadata: map[string]schemamd.Metadata{
+					"name": {
+						MinLength: &minLength,
+						MaxLength: &maxLength,
+						Pattern:   "^[a-z][a-z0-9-]*$",
+					},
+					"settings.port": {
+						Default:       json.Number("443"),
+						OneOf:         []any{json.Number("443"), json.Number("8443")},
+						ConflictsWith: []string{"endpoint"},
+					},
+				},
+			},
+		},
+		"state store": {
+			Dir:  "state-stores/",
+			Name: "scaffolding_store",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Metadata: map[string]schemamd.Metadata{
+					"lock_table": {
+						StateLocking: true,
+					},
+					"workspace_prefix": {
+						Workspaces: true,
+					},
+				},
+			},
+		},
+		"qualified name": {
+			Dir:  "data-sources/",
+			Name: "scaffolding_thing",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Metadata: map[string]schemamd.Metadata{
+					"enabled": {
+						Default: true,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := metadata.Options(schemamd.Options{BlocksLast: true}, testCase.Dir, testCase.Name)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaMetadataFile_UnknownKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := schemaMetadataFile("testdata/schema-metadata-unknown-key.json")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := `error parsing schema metadata file (testdata/schema-metadata-unknown-key.json): json: unknown field "one_off"`
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
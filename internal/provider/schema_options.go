@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+// SchemaOrder represents a schema order file, which lists the attributes and
+// blocks written first within each group of the generated schema
+// documentation, per resource, data source, etc. Names can be qualified with
+// the documentation directory (e.g. "data-sources/scaffolding_thing") to only
+// apply to that directory. The provider schema uses the "provider" name.
+//
+// For example:
+//
+//	provider:
+//	  - endpoint
+//	scaffolding_example:
+//	  - name
+//	  - settings
+//	  - settings.port
+//	data-sources/scaffolding_thing:
+//	  - id
+type SchemaOrder map[string][]string
+
+func schemaOrderFile(path string) (SchemaOrder, error) {
+	log.Printf("[DEBUG] Reading Schema Order File %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema order file (%s): %w", path, err)
+	}
+
+	var order SchemaOrder
+
+	err = yaml.Unmarshal(content, &order)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema order file (%s): %w", path, err)
+	}
+
+	return order, nil
+}
+
+// Options returns the given schema options with the attribute and block order
+// of the given name in the given documentation directory (e.g. "resources"),
+// if the name is in the schema order file.
+func (o SchemaOrder) Options(opts schemamd.Options, dir, name string) schemamd.Options {
+	if order, ok := lookup(o, dir, name); ok {
+		opts.Order = order
+	}
+
+	return opts
+}
+
+// lookup returns the value of the given name in the given documentation
+// directory (e.g. "resources"), preferring a name qualified with the directory
+// (e.g. "resources/scaffolding_example") over the unqualified name.
+func lookup[T any](m map[string]T, dir, name string) (T, bool) {
+	if v, ok := m[strings.Trim(dir, "/")+"/"+name]; ok {
+		return v, true
+	}
+
+	v, ok := m[name]
+	return v, ok
+}
+
+// schemaKinds are the kinds of schemas documented in each documentation
+// directory, as used in default descriptions.
+var schemaKinds = map[string]string{
+	"":                    "provider",
+	"actions":             "action",
+	"data-sources":        "data source",
+	"ephemeral-resources": "ephemeral resource",
+	"list-resources":      "list resource",
+	"resources":           "resource",
+	"state-stores":        "state store",
+}
+
+// schemaPaths returns the attribute and block paths of each name in the given
+// schema overrides or metadata.
+func schemaPaths[T any](m map[string]map[string]T) map[string][]string {
+	paths := make(map[string][]string, len(m))
+
+	for name, values := range m {
+		for path := range values {
+			paths[name] = append(paths[name], path)
+		}
+
+		sort.Strings(paths[name])
+	}
+
+	return paths
+}
+
+// unknownSchemaPaths returns a message for each of the given names and
+// attribute and block paths of a schema order, overrides or metadata file
+// which do not match a schema, attribute or block of the given provider
+// schema, e.g. because they are misspelled or were renamed. Names which are
+// not qualified with a documentation directory match the schemas of that
+// name in every directory, and a path matches if any of them has it.
+func unknownSchemaPaths(providerSchema *tfjson.ProviderSchema, paths map[string][]string) []string {
+	dirBlocks := schemaBlocksByDir(providerSchema)
+
+	var messages []string
+
+	for _, name := range sortedKeys(paths) {
+		var blocks []*tfjson.SchemaBlock
+
+		if dir, dirName, ok := strings.Cut(name, "/"); ok {
+			if block, ok := dirBlocks[dir][dirName]; ok {
+				blocks = append(blocks, block)
+			}
+		} else {
+			for _, dir := range sortedKeys(dirBlocks) {
+				if block, ok := dirBlocks[dir][name]; ok {
+					blocks = append(blocks, block)
+				}
+			}
+		}
+
+		if len(blocks) == 0 {
+			messages = append(messages, fmt.Sprintf("%q does not match a schema", name))
+			continue
+		}
+
+		for _, path := range paths[name] {
+			found := false
+			for _, block := range blocks {
+				if schemamd.HasPath(block, path) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				messages = append(messages, fmt.Sprintf("%q of %q does not match an attribute or block", path, name))
+			}
+		}
+	}
+
+	return messages
+}
+
+// schemaBlocksByDir returns the root blocks of the given provider schema by
+// documentation directory and name. The provider schema uses the "provider"
+// name in the "" directory.
+func schemaBlocksByDir(providerSchema *tfjson.ProviderSchema) map[string]map[string]*tfjson.SchemaBlock {
+	dirBlocks := map[string]map[string]*tfjson.SchemaBlock{
+		"": {},
+	}
+
+	if providerSchema.ConfigSchema != nil {
+		dirBlocks[""]["provider"] = providerSchema.ConfigSchema.Block
+	}
+
+	for dir, schemas := range map[string]map[string]*tfjson.Schema{
+		"data-sources":        providerSchema.DataSourceSchemas,
+		"ephemeral-resources": providerSchema.EphemeralResourceSchemas,
+		"list-resources":      providerSchema.ListResourceSchemas,
+		"resources":           providerSchema.ResourceSchemas,
+		"state-stores":        providerSchema.StateStoreSchemas,
+	} {
+		dirBlocks[dir] = make(map[string]*tfjson.SchemaBlock, len(schemas))
+
+		for name, schema := range schemas {
+			dirBlocks[dir][name] = schema.Block
+		}
+	}
+
+	dirBlocks["actions"] = make(map[string]*tfjson.SchemaBlock, len(providerSchema.ActionSchemas))
+
+	for name, schema := range providerSchema.ActionSchemas {
+		dirBlocks["actions"][name] = schema.Block
+	}
+
+	return dirBlocks
+}
+
+// schemaOptionsFor returns the given schema options for the given name in the
+// given documentation directory, with the kind of the directory and the
+// schema order, overrides and metadata of the name.
+func schemaOptionsFor(opts schemamd.Options, order SchemaOrder, overrides SchemaOverrides, metadata SchemaMetadata, dir, name string) schemamd.Options {
+	opts.Kind = schemaKinds[strings.Trim(dir, "/")]
+	opts = order.Options(opts, dir, name)
+	opts = overrides.Options(opts, dir, name)
+
+	return metadata.Options(opts, dir, name)
+}
+
+// schemaOptionsWith returns the given schema options with the given template
+// options applied. Options are:
+//
+//   - "blocks-last": write blocks after attributes within each group.
+//   - "groups=write-only,sensitive": write write-only and/or sensitive
+//     attributes in separate groups.
+//   - "order=name,settings.port": write the given attributes and blocks first
+//     within each group, in the given order.
+//   - "type-constraints": write the type constraint syntax of attributes.
+//   - "id-description=The name of the thing.": replace the default
+//     description of a top-level `id` attribute without a description.
+//   - "no-default-id": document a top-level `id` attribute without a
+//     description like every other attribute.
+func schemaOptionsWith(opts schemamd.Options, options []string) (schemamd.Options, error) {
+	for _, option := range options {
+		key, value, _ := strings.Cut(option, "=")
+
+		switch strings.TrimSpace(key) {
+		case "blocks-last":
+			opts.BlocksLast = true
+		case "groups":
+			var err error
+			opts, err = schemaGroupsOptions(opts, value)
+			if err != nil {
+				return opts, err
+			}
+		case "order":
+			opts.Order = nil
+			for _, path := range strings.Split(value, ",") {
+				if path = strings.TrimSpace(path); path != "" {
+					opts.Order = append(opts.Order, path)
+				}
+			}
+		case "type-constraints":
+			opts.TypeConstraints = true
+		case "id-description":
+			opts.IDDescription = strings.TrimSpace(value)
+		case "no-default-id":
+			opts.DisableIDDefault = true
+		default:
+			return opts, fmt.Errorf("unknown schema option %q", option)
+		}
+	}
+
+	return opts, nil
+}
+
+// schemaGroupsOptions returns the given schema options with the given comma
+// separated list of additional attribute groups enabled.
+func schemaGroupsOptions(opts schemamd.Options, groups string) (schemamd.Options, error) {
+	for _, group := range strings.Split(groups, ",") {
+		switch strings.TrimSpace(group) {
+		case "write-only":
+			opts.WriteOnlyGroup = true
+		case "sensitive":
+			opts.SensitiveGroup = true
+		case "":
+		default:
+			return opts, fmt.Errorf("unknown schema group %q, expected \"write-only\" or \"sensitive\"", group)
+		}
+	}
+
+	return opts, nil
+}
+
+// schemaMarkdownRenderer renders a schema as Markdown with template options,
+// so templates can override the grouping and ordering of the schema.
+type schemaMarkdownRenderer struct {
+	schemaOptions schemamd.Options
+	render        func(schemamd.Options) (string, error)
+}
+
+// SchemaMarkdownWith returns the Markdown formatted schema, rendered with the
+// given options (e.g. "blocks-last", "groups=write-only,sensitive" or
+// "order=name,settings.port") in addition to the generate options.
+func (r schemaMarkdownRenderer) SchemaMarkdownWith(options ...string) (string, error) {
+	if r.render == nil {
+		return "", fmt.Errorf("schema not found")
+	}
+
+	opts, err := schemaOptionsWith(r.schemaOptions, options)
+	if err != nil {
+		return "", err
+	}
+
+	return r.render(opts)
+}
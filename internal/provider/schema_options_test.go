@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestSchemaOrder_Options(t *testing.T) {
+	t.Parallel()
+
+	order, err := schemaOrderFile("testdata/schema-order.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		Dir      string
+		Name     string
+		Expected schemamd.Options
+	}{
+		"provider": {
+			Name: "provider",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Order:      []string{"endpoint"},
+			},
+		},
+		"name": {
+			Dir:  "resources/",
+			Name: "scaffolding_example",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Order:      []string{"name", "settings", "settings.port"},
+			},
+		},
+		"qualified name": {
+			Dir:  "data-sources/",
+			Name: "scaffolding_thing",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Order:      []string{"id"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := order.Options(schemamd.Options{BlocksLast: true}, testCase.Dir, testCase.Name)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]string{
+		"scaffolding_example":                "unqualified",
+		"data-sources/scaffolding_example":   "qualified",
+		"data-sources/scaffolding_data_only": "qualified only",
+		"provider":                           "provider",
+	}
+
+	testCases := map[string]struct {
+		Dir           string
+		Name          string
+		Expected      string
+		ExpectedFound bool
+	}{
+		"unqualified": {
+			Dir:           "resources/",
+			Name:          "scaffolding_example",
+			Expected:      "unqualified",
+			ExpectedFound: true,
+		},
+		"qualified preferred": {
+			Dir:           "data-sources/",
+			Name:          "scaffolding_example",
+			Expected:      "qualified",
+			ExpectedFound: true,
+		},
+		"qualified without slash": {
+			Dir:           "data-sources",
+			Name:          "scaffolding_data_only",
+			Expected:      "qualified only",
+			ExpectedFound: true,
+		},
+		"qualified other directory": {
+			Dir:  "resources/",
+			Name: "scaffolding_data_only",
+		},
+		"provider": {
+			Name:          "provider",
+			Expected:      "provider",
+			ExpectedFound: true,
+		},
+		"not found": {
+			Dir:  "resources/",
+			Name: "scaffolding_thing",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found := lookup(m, testCase.Dir, testCase.Name)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+
+			if found != testCase.ExpectedFound {
+				t.Errorf("expected found %t, got %t", testCase.ExpectedFound, found)
+			}
+		})
+	}
+}
+
+func TestUnknownSchemaPaths(t *testing.T) {
+	t.Parallel()
+
+	block := func(names ...string) *tfjson.SchemaBlock {
+		attributes := make(map[string]*tfjson.SchemaAttribute, len(names))
+		for _, name := range names {
+			attributes[name] = &tfjson.SchemaAttribute{AttributeType: cty.String}
+		}
+
+		return &tfjson.SchemaBlock{Attributes: attributes}
+	}
+
+	providerSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{
+			Block: block("endpoint"),
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {Block: block("name")},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {Block: block("id", "filter")},
+		},
+	}
+
+	got := unknownSchemaPaths(providerSchema, map[string][]string{
+		"provider":                         {"endpoint", "region"},
+		"scaffolding_example":              {"name", "filter", "nmae"},
+		"data-sources/scaffolding_example": {"filter", "name"},
+		"resources/scaffolding_thing":      {"id"},
+		"scaffolding_other":                {"id"},
+	})
+
+	expected := []string{
+		`"name" of "data-sources/scaffolding_example" does not match an attribute or block`,
+		`"region" of "provider" does not match an attribute or block`,
+		`"resources/scaffolding_thing" does not match a schema`,
+		`"nmae" of "scaffolding_example" does not match an attribute or block`,
+		`"scaffolding_other" does not match a schema`,
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSchemaOptionsWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Options       []string
+		Expected      schemamd.Options
+		ExpectedError string
+	}{
+		"none": {
+			Expected: schemamd.Options{
+				Order: []string{"id"},
+			},
+		},
+		"blocks last": {
+			Options: []string{"blocks-last"},
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Order:      []string{"id"},
+			},
+		},
+		"groups": {
+			Options: []string{"groups=write-only, sensitive"},
+			Expected: schemamd.Options{
+				WriteOnlyGroup: true,
+				SensitiveGroup: true,
+				Order:          []string{"id"},
+			},
+		},
+		"order": {
+			Options: []string{"order=name, settings.port"},
+			Expected: schemamd.Options{
+				Order: []string{"name", "settings.port"},
+			},
+		},
+		"type constraints": {
+			Options: []string{"type-constraints", "groups=sensitive"},
+			Expected: schemamd.Options{
+				TypeConstraints: true,
+				SensitiveGroup:  true,
+				Order:           []string{"id"},
+			},
+		},
+		"id description": {
+			Options: []string{"id-description=The name of the thing."},
+			Expected: schemamd.Options{
+				IDDescription: "The name of the thing.",
+				Order:         []string{"id"},
+			},
+		},
+		"no default id": {
+			Options: []string{"no-default-id"},
+			Expected: schemamd.Options{
+				DisableIDDefault: true,
+				Order:            []string{"id"},
+			},
+		},
+		"unknown group": {
+			Options:       []string{"groups=deprecated"},
+			ExpectedError: `unknown schema group "deprecated"`,
+		},
+		"unknown option": {
+			Options:       []string{"attributes-last"},
+			ExpectedError: `unknown schema option "attributes-last"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schemaOptionsWith(schemamd.Options{Order: []string{"id"}}, testCase.Options)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+// SchemaOverrides represents a schema overrides file, which overrides the
+// generated documentation of attributes and blocks by path, per resource,
+// data source, etc., without changing the provider schema. Names can be
+// qualified with the documentation directory (e.g.
+// "data-sources/scaffolding_thing") to only apply to that directory. The
+// provider schema uses the "provider" name.
+//
+// For example:
+//
+//	scaffolding_example:
+//	  name:
+//	    markdown: Changing the name forces a new resource to be created.
+//	    example: |
+//	      name = "example"
+//	  settings.port:
+//	    description: Port of the endpoint, see the [endpoints guide](../guides/endpoints.md).
+type SchemaOverrides map[string]map[string]schemamd.Override
+
+func schemaOverridesFile(path string) (SchemaOverrides, error) {
+	log.Printf("[DEBUG] Reading Schema Overrides File %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema overrides file (%s): %w", path, err)
+	}
+
+	var overrides SchemaOverrides
+
+	// Unknown keys (e.g. a misspelled "markdwon") are errors, instead of being
+	// silently ignored.
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+
+	err = dec.Decode(&overrides)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing schema overrides file (%s): %w", path, err)
+	}
+
+	return overrides, nil
+}
+
+// Options returns the given schema options with the overrides of the given
+// name in the given documentation directory (e.g. "resources"), if the name
+// is in the schema overrides file.
+func (o SchemaOverrides) Options(opts schemamd.Options, dir, name string) schemamd.Options {
+	if overrides, ok := lookup(o, dir, name); ok {
+		opts.Overrides = overrides
+	}
+
+	return opts
+}
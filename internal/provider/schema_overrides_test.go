@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestSchemaOverrides_Options(t *testing.T) {
+	t.Parallel()
+
+	overrides, err := schemaOverridesFile("testdata/schema-overrides.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		Dir      string
+		Name     string
+		Expected schemamd.Options
+	}{
+		"provider": {
+			Name: "provider",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Overrides: map[string]schemamd.Override{
+					"endpoint": {
+						Description: "Endpoint of the API, see the [endpoints guide](guides/endpoints.md).",
+					},
+				},
+			},
+		},
+		"name": {
+			Dir:  "resources/",
+			Name: "scaffolding_example",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Overrides: map[string]schemamd.Override{
+					"name": {
+						Markdown: "Changing the name forces a new resource to be created.",
+						Example:  "name = \"example\"\n",
+					},
+					"settings.port": {
+						Description: "Port of the settings.",
+					},
+				},
+			},
+		},
+		"qualified name": {
+			Dir:  "data-sources/",
+			Name: "scaffolding_thing",
+			Expected: schemamd.Options{
+				BlocksLast: true,
+				Overrides: map[string]schemamd.Override{
+					"id": {
+						Description: "The ID of the thing.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := overrides.Options(schemamd.Options{BlocksLast: true}, testCase.Dir, testCase.Name)
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaOverridesFile_UnknownKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := schemaOverridesFile("testdata/schema-overrides-unknown-key.yml")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := "error parsing schema overrides file (testdata/schema-overrides-unknown-key.yml): yaml: unmarshal errors:\n  line 3: field markdwon not found in type schemamd.Override"
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
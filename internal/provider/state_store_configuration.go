@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+// stateStoreAttributes returns the sorted names of the root attributes of the
+// given state store schema whose metadata matches, e.g. the attributes which
+// the schema metadata file declares to configure state locking.
+func stateStoreAttributes(schema *tfjson.Schema, metadata map[string]schemamd.Metadata, match func(schemamd.Metadata) bool) []string {
+	var result []string
+
+	if schema == nil || schema.Block == nil {
+		return result
+	}
+
+	for name := range schema.Block.Attributes {
+		if md, ok := metadata[name]; ok && match(md) {
+			result = append(result, name)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// renderStateStoreConfigurationMarkdown returns a Markdown formatted example
+// of configuring the given state store in a terraform block, setting the
+// required root attributes of the schema from variables.
+func renderStateStoreConfigurationMarkdown(name, providerShortName string, schema *tfjson.Schema) string {
+	var required []string
+
+	if schema != nil && schema.Block != nil {
+		for attrName, attr := range schema.Block.Attributes {
+			if attr.Required {
+				required = append(required, attrName)
+			}
+		}
+	}
+
+	slices.Sort(required)
+
+	var b strings.Builder
+
+	b.WriteString("Configure the state store with a `state_store` block in the `terraform` block, for example:\n\n")
+	b.WriteString("```terraform\n")
+	b.WriteString("terraform {\n")
+	b.WriteString("  state_store \"" + name + "\" {\n")
+	b.WriteString("    provider \"" + providerShortName + "\" {}\n")
+
+	if len(required) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, attrName := range required {
+		b.WriteString("    " + attrName + " = var." + attrName + "\n")
+	}
+
+	b.WriteString("  }\n")
+	b.WriteString("}\n")
+	b.WriteString("```\n")
+
+	return b.String()
+}
+
+// renderStateStoreNotesMarkdown returns Markdown formatted notes listing the
+// given attributes configuring state locking and workspaces, or an empty
+// string if there are none.
+//
+// The provider schema does not describe the locking and workspace support of
+// state stores, so the attributes are declared in the schema metadata file.
+func renderStateStoreNotesMarkdown(lockingAttributes, workspaceAttributes []string) string {
+	var b strings.Builder
+
+	if len(lockingAttributes) > 0 {
+		b.WriteString("The following arguments configure state locking:\n\n")
+
+		for _, attrName := range lockingAttributes {
+			b.WriteString("- `" + attrName + "`\n")
+		}
+	}
+
+	if len(workspaceAttributes) > 0 {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("The following arguments configure [workspaces](https://developer.hashicorp.com/terraform/language/state/workspaces):\n\n")
+
+		for _, attrName := range workspaceAttributes {
+			b.WriteString("- `" + attrName + "`\n")
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+type stateStoreTemplate string
+
+type StateStoreTemplateType struct {
+	Type        string
+	Name        string
+	Description string
+	Subcategory string
+
+	HasExample   bool
+	HasExamples  bool
+	ExampleFile  string
+	ExampleFiles []string
+
+	ProviderName      string
+	ProviderShortName string
+
+	SchemaMarkdown        string
+	ConfigurationMarkdown string
+
+	LockingAttributes   []string
+	WorkspaceAttributes []string
+	NotesMarkdown       string
+
+	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
+}
+
+// Render renders the state store template. The locking and workspace
+// attributes are declared in the schema metadata of schemaOpts, as the
+// provider schema does not describe state store capabilities.
+func (t stateStoreTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, schema *tfjson.Schema, schemaOpts schemamd.Options, subcategory string, frontMatter map[string]string) (string, error) {
+	renderSchema := func(opts schemamd.Options) (string, error) {
+		schemaBuffer := bytes.NewBuffer(nil)
+		err := schemamd.RenderStateStore(schema, schemaBuffer, opts)
+		if err != nil {
+			return "", err
+		}
+
+		return schemaComment + "\n" + schemaBuffer.String(), nil
+	}
+
+	schemaMarkdown, err := renderSchema(schemaOpts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render schema: %w", err)
+	}
+
+	s := string(t)
+	if s == "" {
+		return "", nil
+	}
+
+	lockingAttributes := stateStoreAttributes(schema, schemaOpts.Metadata, func(md schemamd.Metadata) bool { return md.StateLocking })
+	workspaceAttributes := stateStoreAttributes(schema, schemaOpts.Metadata, func(md schemamd.Metadata) bool { return md.Workspaces })
+
+	return renderStringTemplate(providerDir, "stateStoreTemplate", s, StateStoreTemplateType{
+		Type:        typeName,
+		Name:        name,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
+		Subcategory: subcategory,
+
+		HasExample:   exampleFile != "" && fileExists(exampleFile),
+		HasExamples:  len(exampleFiles) > 0,
+		ExampleFile:  exampleFile,
+		ExampleFiles: exampleFiles,
+
+		ProviderName:      providerName,
+		ProviderShortName: providerShortName(renderedProviderName),
+
+		SchemaMarkdown:        schemaMarkdown,
+		ConfigurationMarkdown: renderStateStoreConfigurationMarkdown(name, providerShortName(providerName), schema),
+
+		LockingAttributes:   lockingAttributes,
+		WorkspaceAttributes: workspaceAttributes,
+		NotesMarkdown:       renderStateStoreNotesMarkdown(lockingAttributes, workspaceAttributes),
+
+		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render:        renderSchema,
+		},
+	})
+}
+
+const defaultStateStoreTemplate stateStoreTemplate = `---
+` + frontmatterComment + `
+page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
+{{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+---
+
+# {{.Name}} ({{.Type}})
+
+{{ .Description | trimspace }}
+
+## Example Usage
+{{- if .HasExamples }}
+{{- range .ExampleFiles }}
+
+{{ tffile . }}
+{{- end }}
+{{- else }}
+
+{{ .ConfigurationMarkdown | trimspace }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+{{- if .NotesMarkdown }}
+
+## Locking and Workspaces
+
+{{ .NotesMarkdown | trimspace }}
+{{- end }}
+`
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestStateStoreTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ExampleFiles         []string
+		RenderedProviderName string
+		Attributes           map[string]*tfjson.SchemaAttribute
+		Metadata             map[string]schemamd.Metadata
+		Expected             string
+	}{
+		"examples": {
+			ExampleFiles: []string{"state-store.tf"},
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"bucket": {
+					AttributeType: cty.String,
+					Description:   "Bucket to store state in.",
+					Required:      true,
+				},
+			},
+			Expected: "true\n" +
+				"Configure the state store with a `state_store` block in the `terraform` block, for example:\n\n" +
+				"```terraform\n" +
+				"terraform {\n" +
+				"  state_store \"test_store\" {\n" +
+				"    provider \"test\" {}\n\n" +
+				"    bucket = var.bucket\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n\n",
+		},
+		"locking and workspace attributes": {
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"lock_table": {
+					AttributeType: cty.String,
+					Optional:      true,
+				},
+				"lock_timeout": {
+					AttributeType: cty.String,
+					Optional:      true,
+				},
+				"skip_locking": {
+					AttributeType: cty.Bool,
+					Optional:      true,
+				},
+				"workspace_prefix": {
+					AttributeType: cty.String,
+					Optional:      true,
+				},
+			},
+			Metadata: map[string]schemamd.Metadata{
+				"lock_table": {
+					StateLocking: true,
+				},
+				"skip_locking": {
+					StateLocking: true,
+				},
+				"workspace_prefix": {
+					Workspaces: true,
+				},
+				"unknown": {
+					StateLocking: true,
+				},
+			},
+			Expected: "false\n" +
+				"Configure the state store with a `state_store` block in the `terraform` block, for example:\n\n" +
+				"```terraform\n" +
+				"terraform {\n" +
+				"  state_store \"test_store\" {\n" +
+				"    provider \"test\" {}\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n\n" +
+				"The following arguments configure state locking:\n\n" +
+				"- `lock_table`\n" +
+				"- `skip_locking`\n\n" +
+				"The following arguments configure [workspaces](https://developer.hashicorp.com/terraform/language/state/workspaces):\n\n" +
+				"- `workspace_prefix`\n",
+		},
+		"workspace attributes": {
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"workspace_prefix": {
+					AttributeType: cty.String,
+					Optional:      true,
+				},
+			},
+			Metadata: map[string]schemamd.Metadata{
+				"workspace_prefix": {
+					Workspaces: true,
+				},
+			},
+			Expected: "false\n" +
+				"Configure the state store with a `state_store` block in the `terraform` block, for example:\n\n" +
+				"```terraform\n" +
+				"terraform {\n" +
+				"  state_store \"test_store\" {\n" +
+				"    provider \"test\" {}\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n\n" +
+				"The following arguments configure [workspaces](https://developer.hashicorp.com/terraform/language/state/workspaces):\n\n" +
+				"- `workspace_prefix`\n",
+		},
+		"rendered provider name": {
+			RenderedProviderName: "Example Cloud",
+			Expected: "false\n" +
+				"Configure the state store with a `state_store` block in the `terraform` block, for example:\n\n" +
+				"```terraform\n" +
+				"terraform {\n" +
+				"  state_store \"test_store\" {\n" +
+				"    provider \"test\" {}\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n\n",
+		},
+	}
+
+	template := stateStoreTemplate(`{{ .HasExamples }}
+{{ .ConfigurationMarkdown }}
+{{ .NotesMarkdown }}`)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Description: "Stores state.",
+					Attributes:  testCase.Attributes,
+				},
+			}
+
+			renderedProviderName := testCase.RenderedProviderName
+			if renderedProviderName == "" {
+				renderedProviderName = "terraform-provider-test"
+			}
+
+			got, err := template.Render("testdata/test-state-store-dir", "test_store", "terraform-provider-test", renderedProviderName, "State Store", "", testCase.ExampleFiles, schema, schemamd.Options{Metadata: testCase.Metadata}, "", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SubcategoryRules represents a subcategory rules file, which assigns
+// frontmatter subcategories to generated documentation pages.
+//
+// For example:
+//
+//	mapping:
+//	  scaffolding_example: Examples
+//	  data-sources/scaffolding_thing: Things
+//	rules:
+//	  - subcategory: Compute
+//	    prefix: scaffolding_instance
+//	  - subcategory: Networking
+//	    regex: '^scaffolding_(vpc|subnet)'
+//	    directories: [resources, data-sources]
+type SubcategoryRules struct {
+	// Mapping assigns subcategories to individual names. Names can be
+	// qualified with the documentation directory (e.g.
+	// "data-sources/scaffolding_thing") to only apply to that directory.
+	// Mapping takes precedence over Rules.
+	Mapping map[string]string `yaml:"mapping"`
+
+	// Rules assign subcategories by name prefix or regular expression. The
+	// first matching rule is used.
+	Rules []SubcategoryRule `yaml:"rules"`
+}
+
+// SubcategoryRule represents a subcategory assignment rule.
+type SubcategoryRule struct {
+	// Subcategory is the subcategory assigned to matching names.
+	Subcategory string `yaml:"subcategory"`
+
+	// Prefix, if set, matches names beginning with the prefix.
+	Prefix string `yaml:"prefix"`
+
+	// Regex, if set, matches names matching the regular expression.
+	Regex string `yaml:"regex"`
+
+	// Directories, if set, restricts the rule to the given documentation
+	// directories (e.g. "resources" or "functions").
+	Directories []string `yaml:"directories"`
+
+	regex *regexp.Regexp
+}
+
+func subcategoryRulesFile(path string) (*SubcategoryRules, error) {
+	log.Printf("[DEBUG] Reading Subcategory Rules File %s", path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading subcategory rules file (%s): %w", path, err)
+	}
+
+	var rules SubcategoryRules
+
+	// Unknown keys (e.g. a misspelled "directory") are errors, instead of
+	// being silently ignored.
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+
+	err = dec.Decode(&rules)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing subcategory rules file (%s): %w", path, err)
+	}
+
+	for i := range rules.Rules {
+		rule := &rules.Rules[i]
+
+		if rule.Prefix == "" && rule.Regex == "" {
+			return nil, fmt.Errorf("error parsing subcategory rules file (%s): rule %d must set either prefix or regex", path, i)
+		}
+
+		if rule.Regex == "" {
+			continue
+		}
+
+		rule.regex, err = regexp.Compile(rule.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing subcategory rules file (%s): invalid regex for rule %d: %w", path, i, err)
+		}
+	}
+
+	return &rules, nil
+}
+
+// Validate returns an error for each subcategory that is not in the given
+// allowed subcategories. If no allowed subcategories are given, all
+// subcategories are valid.
+func (r *SubcategoryRules) Validate(allowedSubcategories []string) error {
+	if r == nil || len(allowedSubcategories) == 0 {
+		return nil
+	}
+
+	var result error
+
+	names := make([]string, 0, len(r.Mapping))
+	for name := range r.Mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if subcategory := r.Mapping[name]; !slices.Contains(allowedSubcategories, subcategory) {
+			result = errors.Join(result, fmt.Errorf("subcategory (%s) for %q is not in the allowed list", subcategory, name))
+		}
+	}
+
+	for i, rule := range r.Rules {
+		if !slices.Contains(allowedSubcategories, rule.Subcategory) {
+			result = errors.Join(result, fmt.Errorf("subcategory (%s) for rule %d is not in the allowed list", rule.Subcategory, i))
+		}
+	}
+
+	return result
+}
+
+// Subcategory returns the subcategory for the given name in the given
+// documentation directory (e.g. "resources"), or an empty string if no
+// mapping or rule matches.
+func (r *SubcategoryRules) Subcategory(dir, name string) string {
+	if r == nil {
+		return ""
+	}
+
+	dir = strings.Trim(dir, "/")
+
+	if subcategory, ok := lookup(r.Mapping, dir, name); ok {
+		return subcategory
+	}
+
+	for _, rule := range r.Rules {
+		if len(rule.Directories) > 0 && !slices.Contains(rule.Directories, dir) {
+			continue
+		}
+
+		if rule.Prefix != "" && !strings.HasPrefix(name, rule.Prefix) {
+			continue
+		}
+
+		if rule.regex != nil && !rule.regex.MatchString(name) {
+			continue
+		}
+
+		return rule.Subcategory
+	}
+
+	return ""
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSubcategoryRules_Subcategory(t *testing.T) {
+	t.Parallel()
+
+	rules, err := subcategoryRulesFile("testdata/subcategory-rules.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		Dir      string
+		Name     string
+		Expected string
+	}{
+		"mapping": {
+			Dir:      "resources/",
+			Name:     "scaffolding_example",
+			Expected: "Examples",
+		},
+		"qualified mapping": {
+			Dir:      "data-sources/",
+			Name:     "scaffolding_thing",
+			Expected: "Things",
+		},
+		"qualified mapping - other directory": {
+			Dir:      "resources/",
+			Name:     "scaffolding_thing",
+			Expected: "",
+		},
+		"prefix rule": {
+			Dir:      "ephemeral-resources/",
+			Name:     "scaffolding_instance_password",
+			Expected: "Compute",
+		},
+		"regex rule": {
+			Dir:      "data-sources/",
+			Name:     "scaffolding_subnet",
+			Expected: "Networking",
+		},
+		"regex rule - other directory": {
+			Dir:      "list-resources/",
+			Name:     "scaffolding_subnet",
+			Expected: "",
+		},
+		"function rule": {
+			Dir:      "functions/",
+			Name:     "parse_id",
+			Expected: "Functions",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := rules.Subcategory(testCase.Dir, testCase.Name)
+
+			if got != testCase.Expected {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.Expected, got))
+			}
+		})
+	}
+}
+
+func TestSubcategoryRules_Validate(t *testing.T) {
+	t.Parallel()
+
+	rules, err := subcategoryRulesFile("testdata/subcategory-rules.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		AllowedSubcategories []string
+		ExpectedError        string
+	}{
+		"no allowed subcategories": {},
+		"all allowed": {
+			AllowedSubcategories: []string{"Compute", "Examples", "Functions", "Networking", "Things"},
+		},
+		"not allowed": {
+			AllowedSubcategories: []string{"Compute", "Functions"},
+			ExpectedError: "subcategory (Things) for \"data-sources/scaffolding_thing\" is not in the allowed list\n" +
+				"subcategory (Examples) for \"scaffolding_example\" is not in the allowed list\n" +
+				"subcategory (Networking) for rule 1 is not in the allowed list",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := rules.Validate(testCase.AllowedSubcategories)
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
+func TestSubcategoryRulesFile_UnknownKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := subcategoryRulesFile("testdata/subcategory-rules-unknown-key.yml")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := "error parsing subcategory rules file (testdata/subcategory-rules-unknown-key.yml): yaml: unmarshal errors:\n  line 4: field directory not found in type provider.SubcategoryRule"
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
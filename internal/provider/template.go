@@ -28,22 +28,30 @@ const (
 	signatureComment = "<!-- signature generated by tfplugindocs -->"
 	argumentComment  = "<!-- arguments generated by tfplugindocs -->"
 	variadicComment  = "<!-- variadic argument generated by tfplugindocs -->"
+	nestedComment    = "<!-- nested types generated by tfplugindocs -->"
+	returnComment    = "<!-- return type generated by tfplugindocs -->"
 
 	frontmatterComment = "# generated by https://github.com/hashicorp/terraform-plugin-docs"
+
+	// frontmatterKeys renders the custom frontmatter keys configured by a
+	// frontmatter schema file, if any.
+	frontmatterKeys = `{{- range $key, $value := .FrontMatter }}
+{{ $key }}: {{ printf "%q" $value }}
+{{- end }}
+`
 )
 
 type (
 	resourceTemplate string
 	functionTemplate string
 	providerTemplate string
-
-	docTemplate string
 )
 
 type ResourceTemplateType struct {
 	Type        string
 	Name        string
 	Description string
+	Subcategory string
 
 	HasExample   bool
 	HasExamples  bool
@@ -58,7 +66,12 @@ type ResourceTemplateType struct {
 
 	HasImportIdentityConfig  bool
 	ImportIdentityConfigFile string
-	IdentitySchemaMarkdown   string
+
+	HasDefaultImportIdentityConfig      bool
+	DefaultImportIdentityConfigMarkdown string
+
+	HasIdentity            bool
+	IdentitySchemaMarkdown string
 
 	ProviderName      string
 	ProviderShortName string
@@ -66,6 +79,10 @@ type ResourceTemplateType struct {
 	SchemaMarkdown string
 
 	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
 }
 
 type ProviderTemplateType struct {
@@ -80,13 +97,22 @@ type ProviderTemplateType struct {
 	ProviderShortName string
 	SchemaMarkdown    string
 
+	HasOverview      bool
+	Overview         []OverviewEntry
+	OverviewMarkdown string
+
 	RenderedProviderName string
+
+	FrontMatter map[string]string
+
+	schemaMarkdownRenderer
 }
 
 type FunctionTemplateType struct {
 	Type        string
 	Name        string
 	Description string
+	Subcategory string
 	Summary     string
 
 	HasExample   bool
@@ -97,13 +123,26 @@ type FunctionTemplateType struct {
 	ProviderName      string
 	ProviderShortName string
 
+	FunctionCallName            string
+	FunctionCallExampleMarkdown string
+
 	FunctionSignatureMarkdown string
 	FunctionArgumentsMarkdown string
 
 	HasVariadic                      bool
 	FunctionVariadicArgumentMarkdown string
 
+	HasParameterExamples bool
+	ParameterExamples    []FunctionParameterExample
+
+	FunctionReturnTypeMarkdown string
+
+	FunctionArgumentsWithNestedTypesMarkdown  string
+	FunctionReturnTypeWithNestedTypesMarkdown string
+
 	RenderedProviderName string
+
+	FrontMatter map[string]string
 }
 
 func newTemplate(providerDir, name, text string) (*template.Template, error) {
@@ -177,18 +216,8 @@ func renderStringTemplate(providerDir, name, text string, data interface{}) (str
 	return buf.String(), nil
 }
 
-func (t docTemplate) Render(providerDir string, out io.Writer) error {
-	s := string(t)
-	if s == "" {
-		return nil
-	}
-
-	return renderTemplate(providerDir, "docTemplate", s, out, nil)
-}
-
-func (t providerTemplate) Render(providerDir, providerName, renderedProviderName, exampleFile string, exampleFiles []string, schema *tfjson.Schema) (string, error) {
-	schemaBuffer := bytes.NewBuffer(nil)
-	err := schemamd.Render(schema, schemaBuffer)
+func (t providerTemplate) Render(providerDir, providerName, renderedProviderName, exampleFile string, exampleFiles []string, schema *tfjson.Schema, schemaOpts schemamd.Options, overview []OverviewEntry, includeOverview bool, frontMatter map[string]string) (string, error) {
+	schemaMarkdown, err := renderSchemaMarkdown(schema, schemaOpts)
 	if err != nil {
 		return "", fmt.Errorf("unable to render schema: %w", err)
 	}
@@ -199,7 +228,7 @@ func (t providerTemplate) Render(providerDir, providerName, renderedProviderName
 	}
 
 	return renderStringTemplate(providerDir, "providerTemplate", s, ProviderTemplateType{
-		Description: schema.Block.Description,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
 
 		HasExample:   exampleFile != "" && fileExists(exampleFile),
 		HasExamples:  len(exampleFiles) > 0,
@@ -209,15 +238,30 @@ func (t providerTemplate) Render(providerDir, providerName, renderedProviderName
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(renderedProviderName),
 
-		SchemaMarkdown: schemaComment + "\n" + schemaBuffer.String(),
+		SchemaMarkdown: schemaMarkdown,
+
+		HasOverview:      includeOverview && len(overview) > 0,
+		Overview:         overview,
+		OverviewMarkdown: renderOverviewMarkdown(overview),
 
 		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render: func(opts schemamd.Options) (string, error) {
+				return renderSchemaMarkdown(schema, opts)
+			},
+		},
 	})
 }
 
-func (t resourceTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, importIDConfigFile, importIdentityConfigFile, importCmdFile string, schema *tfjson.Schema, identitySchema *tfjson.IdentitySchema) (string, error) {
-	schemaBuffer := bytes.NewBuffer(nil)
-	err := schemamd.Render(schema, schemaBuffer)
+// Render renders the resource template. If defaultImportIdentityConfig is
+// true and there is an identity schema, but no identity import example file,
+// an identity import example is generated from the identity schema.
+func (t resourceTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, importIDConfigFile, importIdentityConfigFile, importCmdFile string, schema *tfjson.Schema, identitySchema *tfjson.IdentitySchema, defaultImportIdentityConfig bool, schemaOpts schemamd.Options, subcategory string, frontMatter map[string]string) (string, error) {
+	schemaMarkdown, err := renderSchemaMarkdown(schema, schemaOpts)
 	if err != nil {
 		return "", fmt.Errorf("unable to render schema: %w", err)
 	}
@@ -227,29 +271,25 @@ func (t resourceTemplate) Render(providerDir, name, providerName, renderedProvid
 		return "", nil
 	}
 
-	hasImportIdentityConfig := importIdentityConfigFile != "" && fileExists(importIdentityConfigFile)
-	identitySchemaBuffer := bytes.NewBuffer(nil)
-
 	// Always render the identity schema if we have one, so it can be used in custom templates.
-	if identitySchema != nil {
-		_, err := io.WriteString(identitySchemaBuffer, schemaComment+"\n")
-		if err != nil {
-			return "", fmt.Errorf("unable to render identity schema comment: %w", err)
-		}
+	identitySchemaMarkdown, err := renderIdentitySchemaMarkdown(identitySchema, schemaOpts)
+	if err != nil {
+		return "", err
+	}
 
-		err = schemamd.RenderIdentitySchema(identitySchema, identitySchemaBuffer)
-		if err != nil {
-			return "", fmt.Errorf("unable to render identity schema: %w", err)
-		}
-	} else if hasImportIdentityConfig {
-		// If there is an identity example, but we don't have an identity schema, we should return an error to ensure the example file was intended.
-		return "", fmt.Errorf("unable to render: an identity import example (%q) was provided for a resource (%q) that does not support resource identity", importIdentityConfigFile, name)
+	// An identity import example is only documented if there is an identity schema to import with.
+	hasImportIdentityConfig := identitySchema != nil && importIdentityConfigFile != "" && fileExists(importIdentityConfigFile)
+
+	var defaultImportIdentityConfigMarkdown string
+	if defaultImportIdentityConfig && identitySchema != nil && !hasImportIdentityConfig {
+		defaultImportIdentityConfigMarkdown = renderDefaultImportIdentityConfigMarkdown(name, identitySchema)
 	}
 
 	return renderStringTemplate(providerDir, "resourceTemplate", s, ResourceTemplateType{
 		Type:        typeName,
 		Name:        name,
-		Description: schema.Block.Description,
+		Description: schemamd.DescriptionMarkdown(schema.Block.Description, schema.Block.DescriptionKind, "", schemaOpts),
+		Subcategory: subcategory,
 
 		HasExample:   exampleFile != "" && fileExists(exampleFile),
 		HasExamples:  len(exampleFiles) > 0,
@@ -264,33 +304,95 @@ func (t resourceTemplate) Render(providerDir, name, providerName, renderedProvid
 
 		HasImportIdentityConfig:  hasImportIdentityConfig,
 		ImportIdentityConfigFile: importIdentityConfigFile,
-		IdentitySchemaMarkdown:   identitySchemaBuffer.String(),
+
+		HasDefaultImportIdentityConfig:      defaultImportIdentityConfigMarkdown != "",
+		DefaultImportIdentityConfigMarkdown: defaultImportIdentityConfigMarkdown,
+
+		HasIdentity:            identitySchema != nil,
+		IdentitySchemaMarkdown: identitySchemaMarkdown,
 
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(renderedProviderName),
 
-		SchemaMarkdown: schemaComment + "\n" + schemaBuffer.String(),
+		SchemaMarkdown: schemaMarkdown,
 
 		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
+
+		schemaMarkdownRenderer: schemaMarkdownRenderer{
+			schemaOptions: schemaOpts,
+			render: func(opts schemamd.Options) (string, error) {
+				return renderSchemaMarkdown(schema, opts)
+			},
+		},
 	})
 }
 
-func (t functionTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, signature *tfjson.FunctionSignature) (string, error) {
-	funcSig, err := functionmd.RenderSignature(name, signature)
+// renderIdentitySchemaMarkdown returns the Markdown formatted identity schema,
+// or an empty string if there is no identity schema.
+func renderIdentitySchemaMarkdown(identitySchema *tfjson.IdentitySchema, opts schemamd.Options) (string, error) {
+	if identitySchema == nil {
+		return "", nil
+	}
+
+	identitySchemaBuffer := bytes.NewBuffer(nil)
+	err := schemamd.RenderIdentitySchema(identitySchema, identitySchemaBuffer, opts)
+	if err != nil {
+		return "", fmt.Errorf("unable to render identity schema: %w", err)
+	}
+
+	return schemaComment + "\n" + identitySchemaBuffer.String(), nil
+}
+
+// FunctionParameterExample is an example for a single function parameter.
+type FunctionParameterExample struct {
+	// Name is the function parameter name.
+	Name string
+
+	// ExampleFile is the path to the file with the Terraform configuration
+	// example of the parameter.
+	ExampleFile string
+}
+
+func (t functionTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, exampleFiles []string, parameterExamples []FunctionParameterExample, signature *tfjson.FunctionSignature, subcategory string, frontMatter map[string]string) (string, error) {
+	callName := functionmd.CallName(providerShortName(providerName), name)
+
+	funcSig, err := functionmd.RenderSignature(callName, signature)
 	if err != nil {
 		return "", fmt.Errorf("unable to render function signature: %w", err)
 	}
 
-	funcArgs, err := functionmd.RenderArguments(signature)
+	funcCallExample, err := functionmd.RenderCallExample(callName, signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function call example: %w", err)
+	}
+
+	funcArgs, err := functionmd.RenderArguments(signature, false)
 	if err != nil {
 		return "", fmt.Errorf("unable to render function arguments: %w", err)
 	}
 
-	funcVarArg, err := functionmd.RenderVariadicArg(signature)
+	funcVarArg, err := functionmd.RenderVariadicArg(signature, false)
 	if err != nil {
 		return "", fmt.Errorf("unable to render variadic argument: %w", err)
 	}
 
+	funcReturnType, err := functionmd.RenderReturnType(signature, false)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function return type: %w", err)
+	}
+
+	funcArgsWithNestedTypes, err := functionArgumentsMarkdown(signature)
+	if err != nil {
+		return "", err
+	}
+
+	funcReturnTypeWithNestedTypes, err := functionReturnTypeMarkdown(signature)
+	if err != nil {
+		return "", err
+	}
+
 	s := string(t)
 	if s == "" {
 		return "", nil
@@ -300,6 +402,7 @@ func (t functionTemplate) Render(providerDir, name, providerName, renderedProvid
 		Type:        typeName,
 		Name:        name,
 		Description: signature.Description,
+		Subcategory: subcategory,
 		Summary:     signature.Summary,
 
 		HasExample:   exampleFile != "" && fileExists(exampleFile),
@@ -310,21 +413,87 @@ func (t functionTemplate) Render(providerDir, name, providerName, renderedProvid
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(renderedProviderName),
 
+		FunctionCallName:            callName,
+		FunctionCallExampleMarkdown: funcCallExample,
+
 		FunctionSignatureMarkdown: signatureComment + "\n" + funcSig,
 		FunctionArgumentsMarkdown: argumentComment + "\n" + funcArgs,
 
 		HasVariadic:                      signature.VariadicParameter != nil,
 		FunctionVariadicArgumentMarkdown: variadicComment + "\n" + funcVarArg,
 
+		HasParameterExamples: len(parameterExamples) > 0,
+		ParameterExamples:    parameterExamples,
+
+		FunctionReturnTypeMarkdown: returnComment + "\n" + funcReturnType,
+
+		FunctionArgumentsWithNestedTypesMarkdown:  funcArgsWithNestedTypes,
+		FunctionReturnTypeWithNestedTypesMarkdown: funcReturnTypeWithNestedTypes,
+
 		RenderedProviderName: renderedProviderName,
+
+		FrontMatter: frontMatter,
 	})
 }
 
+// functionArgumentsMarkdown returns the Markdown formatted arguments,
+// including any variadic argument, followed by the nested types they link to.
+func functionArgumentsMarkdown(signature *tfjson.FunctionSignature) (string, error) {
+	funcArgs, err := functionmd.RenderArguments(signature, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function arguments: %w", err)
+	}
+
+	result := argumentComment + "\n" + funcArgs
+
+	if signature.VariadicParameter != nil {
+		funcVarArg, err := functionmd.RenderVariadicArg(signature, true)
+		if err != nil {
+			return "", fmt.Errorf("unable to render variadic argument: %w", err)
+		}
+
+		result += "\n" + variadicComment + "\n" + funcVarArg
+	}
+
+	funcNestedTypes, err := functionmd.RenderArgumentNestedTypes(signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function argument nested types: %w", err)
+	}
+
+	if funcNestedTypes != "" {
+		result += "\n\n" + nestedComment + "\n" + funcNestedTypes
+	}
+
+	return result, nil
+}
+
+// functionReturnTypeMarkdown returns the Markdown formatted return type,
+// followed by the nested types it links to.
+func functionReturnTypeMarkdown(signature *tfjson.FunctionSignature) (string, error) {
+	funcReturnType, err := functionmd.RenderReturnType(signature, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function return type: %w", err)
+	}
+
+	result := returnComment + "\n" + funcReturnType
+
+	funcNestedTypes, err := functionmd.RenderReturnNestedTypes(signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to render function return nested types: %w", err)
+	}
+
+	if funcNestedTypes != "" {
+		result += "\n\n" + nestedComment + "\n" + funcNestedTypes
+	}
+
+	return result, nil
+}
+
 const defaultResourceTemplate resourceTemplate = `---
 ` + frontmatterComment + `
 page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
-subcategory: ""
-description: |-
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
 {{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
 ---
 
@@ -342,7 +511,11 @@ description: |-
 {{- end }}
 
 {{ .SchemaMarkdown | trimspace }}
-{{- if or .HasImport .HasImportIDConfig .HasImportIdentityConfig }}
+{{- if and .HasIdentity (not .HasImportIdentityConfig) (not .HasDefaultImportIdentityConfig) }}
+
+{{ .IdentitySchemaMarkdown | trimspace }}
+{{- end }}
+{{- if or .HasImport .HasImportIDConfig .HasImportIdentityConfig .HasDefaultImportIdentityConfig }}
 
 ## Import
 
@@ -354,6 +527,13 @@ In Terraform v1.12.0 and later, the [` + "`" + `import` + "`" + ` block](https:/
 
 {{tffile .ImportIdentityConfigFile }}
 
+{{ .IdentitySchemaMarkdown | trimspace }}
+{{- else if .HasDefaultImportIdentityConfig }}
+
+In Terraform v1.12.0 and later, the [` + "`" + `import` + "`" + ` block](https://developer.hashicorp.com/terraform/language/import) can be used with the ` + "`" + `identity` + "`" + ` attribute, for example:
+
+{{ .DefaultImportIdentityConfigMarkdown | trimspace }}
+
 {{ .IdentitySchemaMarkdown | trimspace }}
 {{- end }}
 {{- if .HasImportIDConfig }}
@@ -373,8 +553,8 @@ The [` + "`" + `terraform import` + "`" + ` command](https://developer.hashicorp
 const defaultFunctionTemplate functionTemplate = `---
 ` + frontmatterComment + `
 page_title: "{{.Name}} {{.Type}} - {{.RenderedProviderName}}"
-subcategory: ""
-description: |-
+subcategory: {{ printf "%q" .Subcategory }}
+` + frontmatterKeys + `description: |-
 {{ .Summary | plainmarkdown | trimspace | prefixlines "  " }}
 ---
 
@@ -382,13 +562,15 @@ description: |-
 
 {{ .Description | trimspace }}
 
-{{ if .HasExamples -}}
 ## Example Usage
-
+{{- if .HasExamples }}
 {{- range .ExampleFiles }}
 
 {{ tffile . }}
 {{- end }}
+{{- else }}
+
+{{ .FunctionCallExampleMarkdown }}
 {{- end }}
 
 ## Signature
@@ -397,16 +579,27 @@ description: |-
 
 ## Arguments
 
-{{ .FunctionArgumentsMarkdown }}
-{{- if .HasVariadic }}
-{{ .FunctionVariadicArgumentMarkdown }}
+{{ .FunctionArgumentsWithNestedTypesMarkdown | trimspace }}
+{{- if .HasParameterExamples }}
+
+### Argument Examples
+{{- range .ParameterExamples }}
+
+#### ` + "`" + `{{ .Name }}` + "`" + `
+
+{{ tffile .ExampleFile }}
 {{- end }}
+{{- end }}
+
+## Return Type
+
+{{ .FunctionReturnTypeWithNestedTypesMarkdown | trimspace }}
 `
 
 const defaultProviderTemplate providerTemplate = `---
 ` + frontmatterComment + `
 page_title: "{{.ProviderShortName}} Provider"
-description: |-
+` + frontmatterKeys + `description: |-
 {{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
 ---
 
@@ -424,6 +617,10 @@ description: |-
 {{- end }}
 
 {{ .SchemaMarkdown | trimspace }}
+{{- if .HasOverview }}
+
+{{ .OverviewMarkdown | trimspace }}
+{{- end }}
 `
 
 const migrateProviderTemplateComment string = `
@@ -9,6 +9,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
 )
 
 func TestRenderStringTemplate(t *testing.T) {
@@ -93,7 +96,7 @@ provider "scaffolding" {
 		},
 	}
 
-	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "test-provider", "Resource", "provider.tf", []string{"provider.tf"}, "", "", "", &schema, nil)
+	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "test-provider", "Resource", "provider.tf", []string{"provider.tf"}, "", "", "", &schema, nil, false, schemamd.Options{}, "", nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -104,6 +107,79 @@ provider "scaffolding" {
 	}
 }
 
+func TestResourceTemplate_Render_Identity(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{},
+	}
+
+	identitySchema := &tfjson.IdentitySchema{
+		Attributes: map[string]*tfjson.IdentityAttribute{
+			"id": {
+				IdentityType:      cty.String,
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ImportIdentityConfigFile    string
+		IdentitySchema              *tfjson.IdentitySchema
+		DefaultImportIdentityConfig bool
+		Expected                    string
+	}{
+		"identity schema and example": {
+			ImportIdentityConfigFile: "testdata/test-provider-dir/provider.tf",
+			IdentitySchema:           identitySchema,
+			Expected:                 "true true false",
+		},
+		"identity schema without example": {
+			IdentitySchema: identitySchema,
+			Expected:       "true false false",
+		},
+		"example without identity schema": {
+			ImportIdentityConfigFile: "testdata/test-provider-dir/provider.tf",
+			Expected:                 "false false false",
+		},
+		"default identity import with example": {
+			ImportIdentityConfigFile:    "testdata/test-provider-dir/provider.tf",
+			IdentitySchema:              identitySchema,
+			DefaultImportIdentityConfig: true,
+			Expected:                    "true true false",
+		},
+		"default identity import without example": {
+			IdentitySchema:              identitySchema,
+			DefaultImportIdentityConfig: true,
+			Expected:                    "true false true\n```terraform\n" + identityImportComment + "\nimport {\n  to = test_thing.example\n  identity = {\n    id = \"id\"\n  }\n}\n```\n",
+		},
+		"default identity import without identity schema": {
+			DefaultImportIdentityConfig: true,
+			Expected:                    "false false false",
+		},
+	}
+
+	tpl := resourceTemplate(`{{ .HasIdentity }} {{ .HasImportIdentityConfig }} {{ .HasDefaultImportIdentityConfig }}
+{{- if .HasDefaultImportIdentityConfig }}
+{{ .DefaultImportIdentityConfigMarkdown }}
+{{- end }}`)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tpl.Render("testdata/test-provider-dir", "test_thing", "test-provider", "test-provider", "Resource", "", nil, "", testCase.ImportIdentityConfigFile, "", schema, testCase.IdentitySchema, testCase.DefaultImportIdentityConfig, schemamd.Options{}, "", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestProviderTemplate_Render(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +209,7 @@ provider "scaffolding" {
 		},
 	}
 
-	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "provider.tf", []string{"provider.tf"}, &schema)
+	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "provider.tf", []string{"provider.tf"}, &schema, schemamd.Options{}, nil, false, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -143,3 +219,237 @@ provider "scaffolding" {
 		t.Errorf("expected: %+v, got: %+v", expectedString, cleanedResult)
 	}
 }
+
+func TestResourceTemplate_Render_FrontMatter(t *testing.T) {
+	t.Parallel()
+
+	expectedString := `---
+` + frontmatterComment + `
+page_title: "test_resource Resource - test-provider"
+subcategory: ""
+min_provider_version: "1.2.0"
+owner: "platform-team"
+description: |-
+  Example description
+---
+`
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Description: "Example description",
+		},
+	}
+
+	frontMatter := map[string]string{
+		"owner":                "platform-team",
+		"min_provider_version": "1.2.0",
+	}
+
+	result, err := defaultResourceTemplate.Render("testdata/test-provider-dir", "test_resource", "test-provider", "test-provider", "Resource", "", nil, "", "", "", &schema, nil, false, schemamd.Options{}, "", frontMatter)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !strings.HasPrefix(result, expectedString) {
+		t.Errorf("expected prefix: %+v, got: %+v", expectedString, result)
+	}
+}
+
+func TestResourceTemplate_Render_Subcategory(t *testing.T) {
+	t.Parallel()
+
+	expectedString := `---
+` + frontmatterComment + `
+page_title: "test_resource Resource - test-provider"
+subcategory: "Networking \\ \"Legacy\""
+description: |-
+  Example description
+---
+`
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Description: "Example description",
+		},
+	}
+
+	result, err := defaultResourceTemplate.Render("testdata/test-provider-dir", "test_resource", "test-provider", "test-provider", "Resource", "", nil, "", "", "", &schema, nil, false, schemamd.Options{}, `Networking \ "Legacy"`, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !strings.HasPrefix(result, expectedString) {
+		t.Errorf("expected prefix: %+v, got: %+v", expectedString, result)
+	}
+}
+
+func TestResourceTemplate_Render_SchemaMarkdownWith(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+				},
+				"password": {
+					AttributeType: cty.String,
+					Optional:      true,
+					Sensitive:     true,
+				},
+				"zone": {
+					AttributeType: cty.String,
+					Optional:      true,
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"assignment": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					Block:       &tfjson.SchemaBlock{},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		Template      string
+		Expected      string
+		ExpectedError string
+	}{
+		"default": {
+			Template: `{{ .SchemaMarkdownWith }}`,
+			Expected: schemaComment + "\n" + `## Schema
+
+### Required
+
+- ` + "`name`" + ` (String)
+
+### Optional
+
+- <a id="parent--nestedblock--assignment"></a>` + "`assignment`" + ` (Block List) (see [below for nested schema](#nestedblock--assignment))
+- ` + "`password`" + ` (String, Sensitive)
+- ` + "`zone`" + ` (String)
+
+<a id="nestedblock--assignment"></a>
+### Nested Schema for ` + "`assignment`" + `
+
+(Block List, Optional)
+
+[Back to ` + "`assignment`" + `](#parent--nestedblock--assignment)
+
+
+`,
+		},
+		"options": {
+			Template: `{{ .SchemaMarkdownWith "groups=sensitive" "blocks-last" "order=zone" }}`,
+			Expected: schemaComment + "\n" + `## Schema
+
+### Required
+
+- ` + "`name`" + ` (String)
+
+### Optional
+
+- ` + "`zone`" + ` (String)
+- <a id="parent--nestedblock--assignment"></a>` + "`assignment`" + ` (Block List) (see [below for nested schema](#nestedblock--assignment))
+
+### Sensitive
+
+- ` + "`password`" + ` (String, Optional, Sensitive)
+
+<a id="nestedblock--assignment"></a>
+### Nested Schema for ` + "`assignment`" + `
+
+(Block List, Optional)
+
+[Back to ` + "`assignment`" + `](#parent--nestedblock--assignment)
+
+
+`,
+		},
+		"unknown option": {
+			Template:      `{{ .SchemaMarkdownWith "attributes-last" }}`,
+			ExpectedError: `unknown schema option "attributes-last"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tpl := resourceTemplate(testCase.Template)
+
+			got, err := tpl.Render("testdata/test-provider-dir", "test_thing", "test-provider", "test-provider", "Resource", "", nil, "", "", "", schema, nil, false, schemamd.Options{}, "", nil)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionTemplate_Render_NestedTypeLinks(t *testing.T) {
+	t.Parallel()
+
+	signature := &tfjson.FunctionSignature{
+		ReturnType: cty.String,
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name:        "config",
+				Description: "Example config.",
+				Type: cty.Object(map[string]cty.Type{
+					"name": cty.String,
+				}),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		Template string
+		Expected string
+	}{
+		"without nested types": {
+			Template: `{{ .FunctionArgumentsMarkdown }}`,
+			Expected: argumentComment + "\n1. `config` (Object) Example config.",
+		},
+		"with nested types": {
+			Template: `{{ .FunctionArgumentsWithNestedTypesMarkdown }}`,
+			Expected: argumentComment + "\n1. `config` (Object) Example config. (see [below for nested type](#nestedtype--config))\n\n" +
+				nestedComment + "\n<a id=\"nestedtype--config\"></a>\n### Nested Type for `config`\n\n- `name` (String)\n\n",
+		},
+		"return type with nested types": {
+			Template: `{{ .FunctionReturnTypeWithNestedTypesMarkdown }}`,
+			Expected: returnComment + "\nThe return type is `String`.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tpl := functionTemplate(testCase.Template)
+
+			got, err := tpl.Render("testdata/test-provider-dir", "example", "test-provider", "test-provider", "Function", "", nil, nil, signature, "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
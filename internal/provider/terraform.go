@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/hashicorp/go-checkpoint"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hc-install/fs"
+	"github.com/hashicorp/hc-install/product"
+	"github.com/hashicorp/hc-install/releases"
+)
+
+// TerraformOptions represents options for finding or downloading the
+// Terraform CLI binary, which is used to export the provider schema.
+type TerraformOptions struct {
+	// Version is the Terraform CLI version to use, which is downloaded if it
+	// is not in the download cache. If empty, a Terraform CLI binary in the
+	// PATH is used, or the latest version is downloaded.
+	Version string
+
+	// Binary is the path to a Terraform CLI binary, which is used instead of
+	// finding or downloading one.
+	Binary string
+
+	// CacheDir is the directory of downloaded Terraform CLI binaries, by
+	// version. Defaults to the "tfplugindocs/terraform" directory in the user
+	// cache directory, or the temporary directory.
+	CacheDir string
+
+	// Offline disables downloads, so the Terraform CLI binary must be given,
+	// in the PATH or in the download cache.
+	Offline bool
+}
+
+// validate returns an error if the options are invalid, so invalid options
+// fail before the provider is built.
+func (o TerraformOptions) validate() error {
+	if o.Binary != "" && o.Version != "" {
+		return errors.New("a Terraform CLI binary and version cannot both be set")
+	}
+
+	if o.Version != "" {
+		if _, err := version.NewVersion(o.Version); err != nil {
+			return fmt.Errorf("invalid Terraform CLI version %q: %w", o.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureTerraform returns the path to the Terraform CLI binary, downloading it
+// into the download cache if needed.
+func (o TerraformOptions) ensureTerraform(ctx context.Context, l *Logger) (string, error) {
+	if err := o.validate(); err != nil {
+		return "", err
+	}
+
+	if o.Binary != "" {
+		l.infof("using Terraform CLI binary: %s", o.Binary)
+
+		tfBin, err := filepath.Abs(o.Binary)
+		if err != nil {
+			return "", fmt.Errorf("unable to get absolute path of Terraform CLI binary %q: %w", o.Binary, err)
+		}
+
+		if _, err := os.Stat(tfBin); err != nil {
+			return "", fmt.Errorf("unable to find Terraform CLI binary %q: %w", o.Binary, err)
+		}
+
+		return tfBin, nil
+	}
+
+	cacheDir, err := o.cacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	if o.Version != "" {
+		v, err := version.NewVersion(o.Version)
+		if err != nil {
+			return "", fmt.Errorf("invalid Terraform CLI version %q: %w", o.Version, err)
+		}
+
+		if o.Offline {
+			l.infof("using Terraform CLI binary version from the download cache: %s", v)
+		} else {
+			l.infof("using Terraform CLI binary version from the download cache if available, otherwise downloading from releases.hashicorp.com: %s", v)
+		}
+
+		return o.ensureVersion(ctx, cacheDir, v)
+	}
+
+	if o.Offline {
+		l.infof("using Terraform CLI binary from PATH if available, otherwise latest Terraform CLI binary in the download cache")
+	} else {
+		l.infof("using Terraform CLI binary from PATH if available, otherwise downloading latest Terraform CLI binary")
+	}
+
+	anyVersion := &fs.AnyVersion{
+		Product: &product.Terraform,
+	}
+
+	tfBin, err := anyVersion.Find(ctx)
+	if err == nil {
+		return tfBin, nil
+	}
+
+	if o.Offline {
+		versions, err := cachedTerraformVersions(cacheDir)
+		if err != nil {
+			return "", err
+		}
+
+		if len(versions) == 0 {
+			return "", fmt.Errorf("no Terraform CLI binary found in PATH or the download cache (%s), and downloads are disabled in offline mode", cacheDir)
+		}
+
+		return o.ensureVersion(ctx, cacheDir, versions[len(versions)-1])
+	}
+
+	resp, err := checkpoint.Check(&checkpoint.CheckParams{
+		Product: product.Terraform.Name,
+		OS:      runtime.GOOS,
+		Arch:    runtime.GOARCH,
+		Force:   true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to find latest Terraform CLI version: %w", err)
+	}
+
+	latest, err := version.NewVersion(resp.CurrentVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid latest Terraform CLI version %q: %w", resp.CurrentVersion, err)
+	}
+
+	return o.ensureVersion(ctx, cacheDir, latest)
+}
+
+// ensureVersion returns the path to the given Terraform CLI version in the
+// download cache, downloading it if needed.
+func (o TerraformOptions) ensureVersion(ctx context.Context, cacheDir string, v *version.Version) (string, error) {
+	versionDir := filepath.Join(cacheDir, v.String())
+	tfBin := filepath.Join(versionDir, product.Terraform.BinaryName())
+
+	if _, err := os.Stat(tfBin); err == nil {
+		log.Printf("[DEBUG] Using cached Terraform CLI binary %s", tfBin)
+		return tfBin, nil
+	}
+
+	if o.Offline {
+		return "", fmt.Errorf("unable to find Terraform CLI version %s in the download cache (%s), and downloads are disabled in offline mode", v, cacheDir)
+	}
+
+	log.Printf("[DEBUG] Downloading Terraform CLI binary version %s into %s", v, versionDir)
+
+	err := os.MkdirAll(cacheDir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("unable to create Terraform CLI download cache directory %q: %w", cacheDir, err)
+	}
+
+	// Download into a temporary directory, which is then moved into place,
+	// so interrupted downloads are not cached.
+	tmpDir, err := os.MkdirTemp(cacheDir, ".download-"+v.String()+"-*")
+	if err != nil {
+		return "", fmt.Errorf("unable to create temporary Terraform CLI download directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	exactVersion := &releases.ExactVersion{
+		Product:    product.Terraform,
+		Version:    v,
+		InstallDir: tmpDir,
+	}
+
+	_, err = exactVersion.Install(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to download Terraform binary: %w", err)
+	}
+
+	err = os.Rename(tmpDir, versionDir)
+	if err != nil {
+		// Another run may have downloaded the same version concurrently.
+		if _, statErr := os.Stat(tfBin); statErr == nil {
+			return tfBin, nil
+		}
+
+		return "", fmt.Errorf("unable to move Terraform CLI binary into download cache directory %q: %w", versionDir, err)
+	}
+
+	return tfBin, nil
+}
+
+// cacheDir returns the directory of downloaded Terraform CLI binaries. If the
+// user cache directory cannot be used (e.g. without a home directory), the
+// temporary directory is used instead.
+func (o TerraformOptions) cacheDir() (string, error) {
+	if o.CacheDir != "" {
+		return filepath.Abs(o.CacheDir)
+	}
+
+	userCacheDir, err := os.UserCacheDir()
+	if err == nil {
+		cacheDir := filepath.Join(userCacheDir, "tfplugindocs", "terraform")
+
+		err = os.MkdirAll(cacheDir, 0755)
+		if err == nil {
+			return cacheDir, nil
+		}
+	}
+
+	log.Printf("[DEBUG] Unable to use user cache directory for Terraform CLI downloads: %s", err)
+
+	return filepath.Join(os.TempDir(), "tfplugindocs", "terraform"), nil
+}
+
+// cachedTerraformVersions returns the Terraform CLI versions in the given
+// download cache directory, sorted from oldest to newest.
+func cachedTerraformVersions(cacheDir string) ([]*version.Version, error) {
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("unable to read Terraform CLI download cache directory %q: %w", cacheDir, err)
+	}
+
+	var versions []*version.Version
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		// Skip temporary download directories and other directories.
+		v, err := version.NewVersion(entry.Name())
+		if err != nil || v.String() != entry.Name() {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(cacheDir, entry.Name(), product.Terraform.BinaryName())); err != nil {
+			continue
+		}
+
+		versions = append(versions, v)
+	}
+
+	sort.Sort(version.Collection(versions))
+
+	return versions, nil
+}
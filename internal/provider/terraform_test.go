@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/hc-install/product"
+)
+
+func TestTerraformOptions_validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Options       TerraformOptions
+		ExpectedError string
+	}{
+		"none": {},
+		"version": {
+			Options: TerraformOptions{Version: "1.9.0"},
+		},
+		"binary": {
+			Options: TerraformOptions{Binary: "/usr/local/bin/terraform"},
+		},
+		"invalid version": {
+			Options:       TerraformOptions{Version: "latest"},
+			ExpectedError: `invalid Terraform CLI version "latest"`,
+		},
+		"binary and version": {
+			Options:       TerraformOptions{Binary: "/usr/local/bin/terraform", Version: "1.9.0"},
+			ExpectedError: "a Terraform CLI binary and version cannot both be set",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Options.validate()
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestTerraformOptions_ensureTerraform(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+	writeTestTerraformBinary(t, filepath.Join(cacheDir, "1.9.0"))
+
+	binary := filepath.Join(t.TempDir(), "terraform")
+	err := os.WriteFile(binary, nil, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		Options       TerraformOptions
+		Expected      string
+		ExpectedError string
+	}{
+		"binary": {
+			Options:  TerraformOptions{Binary: binary},
+			Expected: binary,
+		},
+		"binary not found": {
+			Options:       TerraformOptions{Binary: filepath.Join(cacheDir, "missing")},
+			ExpectedError: "unable to find Terraform CLI binary",
+		},
+		"cached version": {
+			Options:  TerraformOptions{Version: "1.9.0", CacheDir: cacheDir},
+			Expected: filepath.Join(cacheDir, "1.9.0", product.Terraform.BinaryName()),
+		},
+		"cached version - offline": {
+			Options:  TerraformOptions{Version: "v1.9.0", CacheDir: cacheDir, Offline: true},
+			Expected: filepath.Join(cacheDir, "1.9.0", product.Terraform.BinaryName()),
+		},
+		"uncached version - offline": {
+			Options:       TerraformOptions{Version: "1.8.0", CacheDir: cacheDir, Offline: true},
+			ExpectedError: "unable to find Terraform CLI version 1.8.0 in the download cache",
+		},
+		"invalid version": {
+			Options:       TerraformOptions{Version: "1.x", CacheDir: cacheDir, Offline: true},
+			ExpectedError: `invalid Terraform CLI version "1.x"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.Options.ensureTerraform(context.Background(), NewLogger(cli.NewMockUi()))
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTerraformOptions_ensureTerraform_OfflineLatest(t *testing.T) {
+	// Terraform CLI binaries in the PATH are used before the download cache.
+	t.Setenv("PATH", t.TempDir())
+
+	cacheDir := t.TempDir()
+	writeTestTerraformBinary(t, filepath.Join(cacheDir, "1.9.0"))
+	writeTestTerraformBinary(t, filepath.Join(cacheDir, "1.10.0"))
+	writeTestTerraformBinary(t, filepath.Join(cacheDir, ".download-1.11.0-123"))
+
+	err := os.MkdirAll(filepath.Join(cacheDir, "1.12.0"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := TerraformOptions{CacheDir: cacheDir, Offline: true}
+
+	got, err := opts.ensureTerraform(context.Background(), NewLogger(cli.NewMockUi()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := filepath.Join(cacheDir, "1.10.0", product.Terraform.BinaryName())
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	opts.CacheDir = t.TempDir()
+
+	_, err = opts.ensureTerraform(context.Background(), NewLogger(cli.NewMockUi()))
+	if err == nil || !strings.Contains(err.Error(), "no Terraform CLI binary found in PATH or the download cache") {
+		t.Fatalf("expected offline error, got: %v", err)
+	}
+}
+
+func TestTerraformProviderSchemaFromTerraform_Offline(t *testing.T) {
+	// Terraform CLI binaries in the PATH are used before the download cache.
+	t.Setenv("PATH", t.TempDir())
+
+	ui := cli.NewMockUi()
+	tfOpts := TerraformOptions{CacheDir: t.TempDir(), Offline: true}
+
+	// The provider directory is not a Go module, so building it would fail.
+	_, err := TerraformProviderSchemaFromTerraform(context.Background(), "scaffolding", t.TempDir(), ProviderBuildOptions{}, tfOpts, NewLogger(ui))
+	if err == nil || !strings.Contains(err.Error(), "unable to find or download Terraform binary") {
+		t.Fatalf("expected Terraform binary error, got: %v", err)
+	}
+
+	if strings.Contains(ui.OutputWriter.String(), "compiling provider") {
+		t.Errorf("expected no provider build, got output: %s", ui.OutputWriter.String())
+	}
+}
+
+func writeTestTerraformBinary(t *testing.T, dir string) {
+	t.Helper()
+
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, product.Terraform.BinaryName()), nil, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
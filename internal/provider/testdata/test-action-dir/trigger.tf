@@ -0,0 +1,17 @@
+resource "scaffolding_thing" "example" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.testTemplate.example]
+    }
+  }
+}
+
+resource "scaffolding_other" "example" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.otherAction.example]
+    }
+  }
+}
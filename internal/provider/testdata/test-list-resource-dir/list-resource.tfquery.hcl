@@ -0,0 +1,7 @@
+list "test_thing" "example" {
+  provider = test
+
+  config {
+    filter = "example"
+  }
+}
@@ -0,0 +1,7 @@
+terraform {
+  state_store "test_store" {
+    provider "test" {}
+
+    bucket = "example"
+  }
+}
@@ -26,8 +26,9 @@ const (
 	FileExtensionMarkdown     = `.markdown`
 	FileExtensionMd           = `.md`
 
-	DocumentationGlobPattern    = `{docs/index.*,docs/{,cdktf/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions,state-stores}/**/*,website/docs/**/*}`
-	DocumentationDirGlobPattern = `{docs/{,cdktf/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions,state-stores}{,/*},website/docs/**/*}`
+	DocumentationGlobPattern    = `{docs/{,cdktf/*/}index.*,docs/{,cdktf/,cdktf/*/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions,state-stores}/**/*,website/docs/**/*}`
+	DocumentationDirGlobPattern = `{docs/{,cdktf/,cdktf/*/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions,state-stores}{,/*},website/docs/**/*}`
+	GuideGlobPattern            = `{,cdktf/*/}guides/**/*`
 )
 
 var ValidLegacyFileExtensions = []string{
@@ -78,6 +79,45 @@ type ValidatorOptions struct {
 	AllowedGuideSubcategoriesFile    string
 	AllowedResourceSubcategories     string
 	AllowedResourceSubcategoriesFile string
+	FrontMatterSchemaFile            string
+
+	// ValidateExamples enables the function, list resource, state store and
+	// resource identity import example checks.
+	ValidateExamples bool
+
+	// DefaultIdentityImport skips the resource identity import example check,
+	// as the documentation is generated with an identity import example for
+	// resources without one.
+	DefaultIdentityImport bool
+
+	// TerraformBinary is the path to a Terraform CLI binary, which is used to
+	// export the provider schema instead of finding or downloading one.
+	TerraformBinary string
+
+	// TerraformCacheDir is the directory of downloaded Terraform CLI
+	// binaries, by version.
+	TerraformCacheDir string
+
+	// Offline disables downloads of the Terraform CLI binary and Go modules.
+	Offline bool
+
+	// ProviderBuildFlags are space separated additional go build flags of the
+	// provider (e.g. "-tags=integration -trimpath").
+	ProviderBuildFlags string
+
+	// ProviderBuildEnv are comma separated additional go build environment
+	// variables of the provider (e.g. "CGO_ENABLED=0,GOFLAGS=-mod=vendor").
+	// Commas which do not start a new KEY=VALUE variable are part of the
+	// value.
+	ProviderBuildEnv string
+
+	// ProviderBinary is the path to a previously built provider binary, which
+	// is used to export the provider schema instead of building the provider.
+	ProviderBinary string
+
+	// ProviderSourceAddress is the provider source address (e.g.
+	// "registry.terraform.io/example/scaffolding").
+	ProviderSourceAddress string
 }
 
 type validator struct {
@@ -86,12 +126,18 @@ type validator struct {
 	providerFS          fs.FS
 	providersSchemaPath string
 
-	tfVersion      string
-	providerSchema *tfjson.ProviderSchema
+	terraformOptions     TerraformOptions
+	providerBuildOptions ProviderBuildOptions
+	providerSchema       *tfjson.ProviderSchema
 
 	allowedGuideSubcategories    []string
 	allowedResourceSubcategories []string
 
+	frontMatterSchema *FrontMatterSchema
+
+	validateExamples      bool
+	defaultIdentityImport bool
+
 	logger *Logger
 }
 
@@ -133,7 +179,16 @@ func Validate(ui cli.Ui, providerDir, providerName, providersSchemaPath, tfversi
 		providerDir:         providerDir,
 		providerFS:          providerFs,
 		providersSchemaPath: providersSchemaPath,
-		tfVersion:           tfversion,
+
+		terraformOptions: TerraformOptions{
+			Version:  tfversion,
+			Binary:   opts.TerraformBinary,
+			CacheDir: opts.TerraformCacheDir,
+			Offline:  opts.Offline,
+		},
+
+		validateExamples:      opts.ValidateExamples,
+		defaultIdentityImport: opts.DefaultIdentityImport,
 
 		logger: NewLogger(ui),
 	}
@@ -142,6 +197,28 @@ func Validate(ui cli.Ui, providerDir, providerName, providersSchemaPath, tfversi
 		return fmt.Errorf("error loading allowed subcategories: %w", err)
 	}
 
+	if err := v.terraformOptions.validate(); err != nil {
+		return fmt.Errorf("error loading Terraform options: %w", err)
+	}
+
+	providerBuildOptions, err := newProviderBuildOptions(opts.ProviderBuildFlags, opts.ProviderBuildEnv, opts.ProviderBinary, opts.ProviderSourceAddress)
+	if err != nil {
+		return fmt.Errorf("error loading provider build options: %w", err)
+	}
+
+	if err := providerBuildOptions.validateProvidersSchema(providersSchemaPath); err != nil {
+		return fmt.Errorf("error loading provider build options: %w", err)
+	}
+	v.providerBuildOptions = providerBuildOptions
+
+	if o := opts.FrontMatterSchemaFile; o != "" {
+		frontMatterSchema, err := frontMatterSchemaFile(o)
+		if err != nil {
+			return fmt.Errorf("error loading frontmatter schema: %w", err)
+		}
+		v.frontMatterSchema = frontMatterSchema
+	}
+
 	ctx := context.Background()
 
 	return v.validate(ctx)
@@ -187,13 +264,13 @@ func (v *validator) validate(ctx context.Context) error {
 
 	if v.providersSchemaPath == "" {
 		v.logger.infof("exporting schema from Terraform")
-		v.providerSchema, err = TerraformProviderSchemaFromTerraform(ctx, v.providerName, v.providerDir, v.tfVersion, v.logger)
+		v.providerSchema, err = TerraformProviderSchemaFromTerraform(ctx, v.providerName, v.providerDir, v.providerBuildOptions, v.terraformOptions, v.logger)
 		if err != nil {
 			return fmt.Errorf("error exporting provider schema from Terraform: %w", err)
 		}
 	} else {
 		v.logger.infof("exporting schema from JSON file")
-		v.providerSchema, err = TerraformProviderSchemaFromFile(v.providerName, v.providersSchemaPath, v.logger)
+		v.providerSchema, err = TerraformProviderSchemaFromFile(v.providerName, v.providersSchemaPath, v.providerBuildOptions, v.logger)
 		if err != nil {
 			return fmt.Errorf("error exporting provider schema from JSON file: %w", err)
 		}
@@ -221,6 +298,77 @@ func (v *validator) validate(ctx context.Context) error {
 		err = v.validateLegacyWebsite()
 		result = errors.Join(result, err)
 	}
+	if v.validateExamples {
+		err = v.validateExamplesDirs()
+		result = errors.Join(result, err)
+	}
+
+	return result
+}
+
+// validateExamplesDirs runs the checks of the function, list resource,
+// resource and state store examples directories which exist.
+func (v *validator) validateExamplesDirs() error {
+	var result error
+
+	if dirExists(v.providerFS, "examples/functions") {
+		v.logger.infof("detected function examples directory, running checks")
+		err := v.validateFunctionExamples()
+		result = errors.Join(result, err)
+	}
+	if dirExists(v.providerFS, "examples/list-resources") {
+		v.logger.infof("detected list resource examples directory, running checks")
+		err := v.validateListResourceExamples()
+		result = errors.Join(result, err)
+	}
+	if dirExists(v.providerFS, "examples/resources") && !v.defaultIdentityImport {
+		v.logger.infof("detected resource examples directory, running checks")
+		err := v.validateIdentityExamples()
+		result = errors.Join(result, err)
+	}
+	if dirExists(v.providerFS, "examples/state-stores") {
+		v.logger.infof("detected state store examples directory, running checks")
+		err := v.validateStateStoreExamples()
+		result = errors.Join(result, err)
+	}
+
+	return result
+}
+
+// validateFunctionExamples verifies function example files call the function
+// with the provider namespace and a valid number of arguments.
+func (v *validator) validateFunctionExamples() error {
+	var result error
+
+	files, err := doublestar.Glob(v.providerFS, "examples/functions/*/function*.tf")
+	if err != nil {
+		return fmt.Errorf("error finding function example files: %w", err)
+	}
+
+	log.Printf("[DEBUG] Found function example files %v", files)
+
+	for _, file := range files {
+		funcName := filepath.Base(filepath.Dir(file))
+
+		signature, ok := v.providerSchema.Functions[funcName]
+		if !ok {
+			log.Printf("[DEBUG] Skipping function example file %s, function %q not found in provider schema", file, funcName)
+			continue
+		}
+
+		src, err := fs.ReadFile(v.providerFS, file)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("error reading function example file %q: %w", file, err))
+			continue
+		}
+
+		err = check.NewFunctionExampleCheck(&check.FunctionExampleOptions{
+			FunctionName:      funcName,
+			ProviderShortName: providerShortName(v.providerName),
+			Signature:         signature,
+		}).Run(file, src)
+		result = errors.Join(result, err)
+	}
 
 	return result
 }
@@ -263,14 +411,14 @@ func (v *validator) validateStaticDocs() error {
 		}
 
 		// Configure FrontMatterOptions based on file type
-		relativeToGuides, err := filepath.Rel(dir+"/guides", path)
+		isGuide, err := doublestar.Match(dir+"/"+GuideGlobPattern, path)
 		if err != nil {
 			return fmt.Errorf("error determining relative path (%s): %w", path, err)
 		}
 
 		if removeAllExt(d.Name()) == "index" {
 			options.FrontMatter = RegistryIndexFrontMatterOptions
-		} else if filepath.IsLocal(relativeToGuides) {
+		} else if isGuide {
 			options.FrontMatter = RegistryGuideFrontMatterOptions
 
 			if len(v.allowedGuideSubcategories) != 0 {
@@ -283,6 +431,9 @@ func (v *validator) validateStaticDocs() error {
 				options.FrontMatter.AllowedSubcategories = v.allowedResourceSubcategories
 			}
 		}
+
+		options.FrontMatter = v.frontMatterSchema.FrontMatterOptions(options.FrontMatter, documentationDir(dir, path))
+
 		v.logger.infof("running file checks on %s", path)
 		result = errors.Join(result, check.NewProviderFileCheck(v.providerFS, options).Run(path))
 
@@ -332,6 +483,12 @@ func (v *validator) validateStaticDocs() error {
 		result = errors.Join(result, err)
 	}
 
+	if dirExists(v.providerFS, dir+"/"+check.CdktfIndexDirectory) {
+		v.logger.infof("running CDKTF file mismatch check")
+		err = v.cdktfFileMismatchCheck(dir, check.CdktfRegistrySubdirectories)
+		result = errors.Join(result, err)
+	}
+
 	return result
 }
 
@@ -382,6 +539,9 @@ func (v *validator) validateLegacyWebsite() error {
 				options.FrontMatter.AllowedSubcategories = v.allowedResourceSubcategories
 			}
 		}
+
+		options.FrontMatter = v.frontMatterSchema.FrontMatterOptions(options.FrontMatter, documentationDir(dir, path))
+
 		v.logger.infof("running file checks on %s", path)
 		result = errors.Join(result, check.NewProviderFileCheck(v.providerFS, options).Run(path))
 
@@ -431,9 +591,135 @@ func (v *validator) validateLegacyWebsite() error {
 		result = errors.Join(result, err)
 	}
 
+	if dirExists(v.providerFS, dir+"/"+check.CdktfIndexDirectory) {
+		v.logger.infof("running CDKTF file mismatch check")
+		err = v.cdktfFileMismatchCheck(dir, check.CdktfLegacySubdirectories)
+		result = errors.Join(result, err)
+	}
+
+	return result
+}
+
+// cdktfFileMismatchCheck verifies that every valid CDKTF language directory
+// within the given documentation directory contains the same resources, data
+// sources, etc. as the HCL documentation.
+func (v *validator) cdktfFileMismatchCheck(dir string, subdirectories []string) error {
+	cdktfDir := dir + "/" + check.CdktfIndexDirectory
+
+	mismatchOpt := &check.CdktfFileMismatchOptions{
+		DocumentationEntries: make(map[string][]os.DirEntry),
+		LanguageEntries:      make(map[string]map[string][]os.DirEntry),
+	}
+
+	for _, subdirectory := range subdirectories {
+		if dirExists(v.providerFS, dir+"/"+subdirectory) {
+			files, _ := fs.ReadDir(v.providerFS, dir+"/"+subdirectory)
+			mismatchOpt.DocumentationEntries[subdirectory] = files
+		}
+	}
+
+	for _, language := range check.ValidCdktfLanguages {
+		languageDir := cdktfDir + "/" + language
+		if !dirExists(v.providerFS, languageDir) {
+			continue
+		}
+
+		mismatchOpt.LanguageEntries[language] = make(map[string][]os.DirEntry)
+
+		for _, subdirectory := range subdirectories {
+			if dirExists(v.providerFS, languageDir+"/"+subdirectory) {
+				files, _ := fs.ReadDir(v.providerFS, languageDir+"/"+subdirectory)
+				mismatchOpt.LanguageEntries[language][subdirectory] = files
+			}
+		}
+	}
+
+	return check.NewCdktfFileMismatchCheck(mismatchOpt).Run()
+}
+
+// validateListResourceExamples verifies list resource example files
+// (.tfquery.hcl) parse and contain a list block of the list resource.
+func (v *validator) validateListResourceExamples() error {
+	var result error
+
+	files, err := doublestar.Glob(v.providerFS, "examples/list-resources/*/*.tfquery.hcl")
+	if err != nil {
+		return fmt.Errorf("error finding list resource example files: %w", err)
+	}
+
+	log.Printf("[DEBUG] Found list resource example files %v", files)
+
+	for _, file := range files {
+		src, err := fs.ReadFile(v.providerFS, file)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("error reading list resource example file %q: %w", file, err))
+			continue
+		}
+
+		err = check.NewListResourceExampleCheck(&check.ListResourceExampleOptions{
+			ListResourceName: filepath.Base(filepath.Dir(file)),
+		}).Run(file, src)
+		result = errors.Join(result, err)
+	}
+
 	return result
 }
 
+// validateIdentityExamples verifies every resource with an identity schema
+// has an identity import example file.
+func (v *validator) validateIdentityExamples() error {
+	files, err := doublestar.Glob(v.providerFS, "examples/resources/*/import-by-identity.tf")
+	if err != nil {
+		return fmt.Errorf("error finding identity example files: %w", err)
+	}
+
+	log.Printf("[DEBUG] Found identity example files %v", files)
+
+	return check.NewIdentityExampleCheck(&check.IdentityExampleOptions{
+		ExamplesDir: "examples",
+		Schema:      v.providerSchema,
+	}).Run(files)
+}
+
+// validateStateStoreExamples verifies state store example files configure the
+// state store in a terraform block.
+func (v *validator) validateStateStoreExamples() error {
+	var result error
+
+	files, err := doublestar.Glob(v.providerFS, "examples/state-stores/*/state-store*.tf")
+	if err != nil {
+		return fmt.Errorf("error finding state store example files: %w", err)
+	}
+
+	log.Printf("[DEBUG] Found state store example files %v", files)
+
+	for _, file := range files {
+		src, err := fs.ReadFile(v.providerFS, file)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("error reading state store example file %q: %w", file, err))
+			continue
+		}
+
+		err = check.NewStateStoreExampleCheck(&check.StateStoreExampleOptions{
+			StateStoreName: filepath.Base(filepath.Dir(file)),
+		}).Run(file, src)
+		result = errors.Join(result, err)
+	}
+
+	return result
+}
+
+// documentationDir returns the directory of the given documentation file
+// path, relative to the given documentation directory.
+func documentationDir(dir, path string) string {
+	rel, err := filepath.Rel(dir, filepath.Dir(path))
+	if err != nil {
+		return "."
+	}
+
+	return filepath.ToSlash(rel)
+}
+
 func dirExists(fileSys fs.FS, name string) bool {
 	if file, err := fs.Stat(fileSys, name); err != nil {
 		return false
@@ -15,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 	"gopkg.in/yaml.v3"
 )
 
@@ -125,7 +126,7 @@ func TestValidateStaticDocs_DirectoryChecks(t *testing.T) {
 				"docs/cdktf/typescript/ephemeral-resources/thing.md": {
 					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
 				},
-				"docs/cdktf/typescirpt/guides/thing.md": {
+				"docs/cdktf/typescript/guides/thing.md": {
 					Data: encodeYAML(t, &ValidRegistryGuideFrontMatter),
 				},
 				"docs/cdktf/typescript/list-resources/thing.md": {
@@ -146,18 +147,30 @@ func TestValidateStaticDocs_DirectoryChecks(t *testing.T) {
 				"docs/cdktf/typescript/index.md": {
 					Data: encodeYAML(t, &ValidRegistryIndexFrontMatter),
 				},
+				"docs/actions/thing.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
 				"docs/data-sources/thing.md": {
 					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
 				},
+				"docs/ephemeral-resources/thing.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
 				"docs/guides/thing.md": {
 					Data: encodeYAML(t, &ValidRegistryGuideFrontMatter),
 				},
+				"docs/list-resources/thing.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
 				"docs/nonregistrydocs/valid.md": {
 					Data: []byte("non-registry documentation"),
 				},
 				"docs/resources/thing.md": {
 					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
 				},
+				"docs/state-stores/thing.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
 				"docs/CONTRIBUTING.md": {
 					Data: []byte("contribution guidelines"),
 				},
@@ -517,6 +530,46 @@ func TestValidateStaticDocs_FileChecks(t *testing.T) {
 			},
 			ExpectedError: filepath.Join("docs", "index.md") + ": error checking file frontmatter: YAML frontmatter should not contain sidebar_current",
 		},
+		"invalid cdktf files": {
+			ProviderFS: fstest.MapFS{
+				"docs/cdktf/typescript/guides/with_layout.md": {
+					Data: encodeYAML(t,
+						&FrontMatterData{
+							Layout:    &exampleLayout,
+							PageTitle: &examplePageTitle,
+						},
+					),
+				},
+				"docs/cdktf/typescript/index.md": {
+					Data: encodeYAML(t,
+						&FrontMatterData{
+							Subcategory: &exampleSubcategory,
+							PageTitle:   &examplePageTitle,
+							Description: &exampleDescription,
+						},
+					),
+				},
+				"docs/cdktf/typescript/resources/invalid_extension.txt": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+				"docs/cdktf/typescript/resources/with_sidebar_current.md": {
+					Data: encodeYAML(t,
+						&FrontMatterData{
+							SidebarCurrent: &exampleSidebarCurrent,
+							Subcategory:    &exampleSubcategory,
+							PageTitle:      &examplePageTitle,
+							Description:    &exampleDescription,
+						},
+					),
+				},
+			},
+			ExpectedError: filepath.Join("docs", "cdktf", "typescript", "guides", "with_layout.md") + ": error checking file frontmatter: YAML frontmatter should not contain layout\n" +
+				filepath.Join("docs", "cdktf", "typescript", "index.md") + ": error checking file frontmatter: YAML frontmatter should not contain subcategory\n" +
+				filepath.Join("docs", "cdktf", "typescript", "resources", "invalid_extension.txt") + ": error checking file extension: file does not end with a valid extension, valid extensions: [.md]\n" +
+				filepath.Join("docs", "cdktf", "typescript", "resources", "with_sidebar_current.md") + ": error checking file frontmatter: YAML frontmatter should not contain sidebar_current\n" +
+				"matching HCL documentation file for CDKTF typescript documentation file (resources/invalid_extension.txt) not found, file is extraneous or incorrectly named\n" +
+				"matching HCL documentation file for CDKTF typescript documentation file (resources/with_sidebar_current.md) not found, file is extraneous or incorrectly named",
+		},
 		"invalid index file - with subcategory": {
 			ProviderFS: fstest.MapFS{
 				"docs/index.md": {
@@ -556,6 +609,310 @@ func TestValidateStaticDocs_FileChecks(t *testing.T) {
 	}
 }
 
+func TestValidateStaticDocs_FrontMatterSchema(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ProviderFS    fs.FS
+		ExpectedError string
+	}{
+		"valid custom keys": {
+			ProviderFS: fstest.MapFS{
+				"docs/index.md": {
+					Data: []byte("---\npage_title: Test Provider\nowner: platform-team\n---\n"),
+				},
+				"docs/guides/example.md": {
+					Data: []byte("---\npage_title: Example\nstability: beta\n---\n"),
+				},
+				"docs/resources/example.md": {
+					Data: []byte("---\nowner: platform-team\nstability: stable\nmin_provider_version: 1.2.0\n---\n"),
+				},
+			},
+		},
+		"invalid custom keys": {
+			ProviderFS: fstest.MapFS{
+				"docs/guides/example.md": {
+					Data: []byte("---\npage_title: Example\nstability: experimental\n---\n"),
+				},
+				"docs/resources/missing_owner.md": {
+					Data: []byte("---\nstability: stable\n---\n"),
+				},
+				"docs/resources/invalid_version.md": {
+					Data: []byte("---\nowner: platform-team\nmin_provider_version: latest\n---\n"),
+				},
+			},
+			ExpectedError: filepath.Join("docs", "guides", "example.md") + ": error checking file frontmatter: YAML frontmatter contains a stability (experimental) that is not in the allowed list\n" +
+				filepath.Join("docs", "resources", "invalid_version.md") + ": error checking file frontmatter: YAML frontmatter contains a min_provider_version (latest) that does not match the pattern ^\\d+\\.\\d+\\.\\d+$\n" +
+				filepath.Join("docs", "resources", "missing_owner.md") + ": error checking file frontmatter: YAML frontmatter missing required owner",
+		},
+	}
+
+	frontMatterSchema, err := frontMatterSchemaFile("testdata/frontmatter-schema.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &validator{
+				providerFS:   testCase.ProviderFS,
+				providerName: "terraform-provider-test",
+
+				frontMatterSchema: frontMatterSchema,
+
+				logger: NewLogger(cli.NewMockUi()),
+			}
+			got := v.validateStaticDocs()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
+func TestValidateFunctionExamples(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		Functions: map[string]*tfjson.FunctionSignature{
+			"echo": {
+				ReturnType: cty.String,
+				Parameters: []*tfjson.FunctionParameter{
+					{
+						Name: "input",
+						Type: cty.String,
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ProviderFS    fs.FS
+		ExpectedError string
+	}{
+		"valid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/functions/echo/function.tf": {
+					Data: []byte("output \"example\" {\n  value = provider::test::echo(\"hello\")\n}\n"),
+				},
+				"examples/functions/echo/function-upper.tf": {
+					Data: []byte("output \"example\" {\n  value = upper(provider::test::echo(\"hello\"))\n}\n"),
+				},
+				"examples/functions/unknown/function.tf": {
+					Data: []byte("output \"example\" {\n  value = provider::test::unknown()\n}\n"),
+				},
+			},
+		},
+		"invalid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/functions/echo/function.tf": {
+					Data: []byte("output \"example\" {\n  value = echo(\"hello\")\n}\n"),
+				},
+				"examples/functions/echo/function-args.tf": {
+					Data: []byte("output \"example\" {\n  value = provider::test::echo(\"hello\", \"world\")\n}\n"),
+				},
+			},
+			ExpectedError: "examples/functions/echo/function-args.tf:2,11-31: call to \"provider::test::echo\" has 2 arguments, expected 1\n" +
+				"function example file \"examples/functions/echo/function.tf\" does not call \"provider::test::echo\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &validator{
+				providerFS:     testCase.ProviderFS,
+				providerName:   "terraform-provider-test",
+				providerSchema: providerSchema,
+
+				logger: NewLogger(cli.NewMockUi()),
+			}
+			got := v.validateFunctionExamples()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
+func TestValidateListResourceExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ProviderFS    fs.FS
+		ExpectedError string
+	}{
+		"valid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/list-resources/test_pet/list-resource.tfquery.hcl": {
+					Data: []byte("list \"test_pet\" \"example\" {\n  provider = test\n}\n"),
+				},
+			},
+		},
+		"invalid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/list-resources/test_pet/list-resource.tfquery.hcl": {
+					Data: []byte("list \"test_other\" \"example\" {\n  provider = test\n}\n"),
+				},
+				"examples/list-resources/test_pet/list-resource-invalid.tfquery.hcl": {
+					Data: []byte("list \"test_pet\" \"example\" {\n"),
+				},
+			},
+			ExpectedError: "error parsing list resource example file \"examples/list-resources/test_pet/list-resource-invalid.tfquery.hcl\": examples/list-resources/test_pet/list-resource-invalid.tfquery.hcl:1,27-28: Unclosed configuration block; There is no closing brace for this block before the end of the file. This may be caused by incorrect brace nesting elsewhere in this file.\n" +
+				"list resource example file \"examples/list-resources/test_pet/list-resource.tfquery.hcl\" does not contain a list block for \"test_pet\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &validator{
+				providerFS:   testCase.ProviderFS,
+				providerName: "terraform-provider-test",
+
+				logger: NewLogger(cli.NewMockUi()),
+			}
+			got := v.validateListResourceExamples()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
+func TestValidateIdentityExamples(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ProviderFS     fs.FS
+		ProviderSchema *tfjson.ProviderSchema
+		ExpectedError  string
+	}{
+		"valid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/resources/test_pet/import-by-identity.tf": {
+					Data: []byte("import {\n  to = test_pet.example\n  identity = {\n    id = \"example\"\n  }\n}\n"),
+				},
+			},
+			ProviderSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_pet": {},
+				},
+				ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+					"test_pet": {},
+				},
+			},
+		},
+		"missing examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/resources/test_pet/resource.tf": {
+					Data: []byte("resource \"test_pet\" \"example\" {}\n"),
+				},
+			},
+			ProviderSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_pet": {},
+				},
+				ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+					"test_pet": {},
+				},
+			},
+			ExpectedError: "resource \"test_pet\" has an identity schema, but is missing an identity import example file \"examples/resources/test_pet/import-by-identity.tf\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &validator{
+				providerFS:     testCase.ProviderFS,
+				providerName:   "terraform-provider-test",
+				providerSchema: testCase.ProviderSchema,
+
+				logger: NewLogger(cli.NewMockUi()),
+			}
+			got := v.validateIdentityExamples()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
+func TestValidateStateStoreExamples(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ProviderFS    fs.FS
+		ExpectedError string
+	}{
+		"valid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/state-stores/test_store/state-store.tf": {
+					Data: []byte("terraform {\n  state_store \"test_store\" {\n    provider \"test\" {}\n  }\n}\n"),
+				},
+			},
+		},
+		"invalid examples": {
+			ProviderFS: fstest.MapFS{
+				"examples/state-stores/test_store/state-store.tf": {
+					Data: []byte("terraform {\n  state_store \"test_other\" {\n    provider \"test\" {}\n  }\n}\n"),
+				},
+				"examples/state-stores/test_store/state-store-no-provider.tf": {
+					Data: []byte("terraform {\n  state_store \"test_store\" {}\n}\n"),
+				},
+			},
+			ExpectedError: "examples/state-stores/test_store/state-store-no-provider.tf:2,3-14: state_store block for \"test_store\" does not contain a provider block\n" +
+				"state store example file \"examples/state-stores/test_store/state-store.tf\" does not contain a terraform state_store block for \"test_store\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &validator{
+				providerFS:   testCase.ProviderFS,
+				providerName: "terraform-provider-test",
+
+				logger: NewLogger(cli.NewMockUi()),
+			}
+			got := v.validateStateStoreExamples()
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error: %s, but got no error", testCase.ExpectedError)
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("Unexpected response (+wanted, -got): %s", cmp.Diff(testCase.ExpectedError, got.Error()))
+			}
+		})
+	}
+}
+
 func TestValidateStaticDocs_FileMismatchCheck(t *testing.T) {
 	t.Parallel()
 	testCases := map[string]struct {
@@ -673,6 +1030,34 @@ func TestValidateStaticDocs_FileMismatchCheck(t *testing.T) {
 				"missing documentation file for list resource: test_list2\n" +
 				"missing documentation file for state store: test_state_store2",
 		},
+		"invalid - missing cdktf files": {
+			ProviderSchema: &tfjson.ProviderSchema{
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_pet": {},
+				},
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_id": {},
+				},
+			},
+			ProviderFS: fstest.MapFS{
+				"docs/cdktf/python/data-sources/pet.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+				"docs/cdktf/python/resources/id.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+				"docs/cdktf/typescript/resources/id.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+				"docs/data-sources/pet.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+				"docs/resources/id.md": {
+					Data: encodeYAML(t, &ValidRegistryResourceFrontMatter),
+				},
+			},
+			ExpectedError: "missing CDKTF typescript documentation file for data-sources: pet",
+		},
 		"invalid - extra files": {
 			ProviderSchema: &tfjson.ProviderSchema{
 				ActionSchemas: map[string]*tfjson.ActionSchema{
@@ -835,15 +1220,27 @@ func TestValidateLegacyWebsite_DirectoryChecks(t *testing.T) {
 				"website/docs/cdktf/typescript/index.html.markdown": {
 					Data: encodeYAML(t, &ValidLegacyIndexFrontMatter),
 				},
+				"website/docs/actions/thing.html.markdown": {
+					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
+				},
 				"website/docs/d/thing.html.markdown": {
 					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
 				},
+				"website/docs/ephemeral-resources/thing.html.markdown": {
+					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
+				},
+				"website/docs/list-resources/thing.html.markdown": {
+					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
+				},
 				"website/docs/guides/thing.html.markdown": {
 					Data: encodeYAML(t, &ValidLegacyGuideFrontMatter),
 				},
 				"website/docs/r/thing.html.markdown": {
 					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
 				},
+				"website/docs/state-stores/thing.html.markdown": {
+					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
+				},
 				"website/docs/cdktf/typescript/state-stores/thing.html.markdown": {
 					Data: encodeYAML(t, &ValidLegacyResourceFrontMatter),
 				},
@@ -1492,6 +1889,15 @@ func TestDocumentationDirGlobPattern(t *testing.T) {
 		"docs/resources/invalid": {
 			ExpectMatch: true,
 		},
+		"docs/cdktf/typescript/resources": {
+			ExpectMatch: true,
+		},
+		"docs/cdktf/typescript/resources/invalid": {
+			ExpectMatch: true,
+		},
+		"docs/cdktf/typescript/invalid": {
+			ExpectMatch: false,
+		},
 		"docs/index.md": {
 			ExpectMatch: false,
 		},
@@ -15,6 +15,21 @@ func childAttributeIsWriteOnly(att *tfjson.SchemaAttribute) bool {
 	return att.WriteOnly
 }
 
+func childAttributeIsSensitive(att *tfjson.SchemaAttribute) bool {
+	return att.Sensitive
+}
+
+// noBlock never matches a block, for groups which only contain attributes.
+func noBlock(block *tfjson.SchemaBlockType) bool {
+	return false
+}
+
+// noAttributeBlock never matches a name as a block, for object types and
+// nested attribute types, which only contain attributes.
+func noAttributeBlock(name string) bool {
+	return false
+}
+
 func childBlockIsRequired(block *tfjson.SchemaBlockType) bool {
 	return block.MinItems > 0
 }
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// markdownEscapeChars are characters with a meaning anywhere in a Markdown
+// line, such as emphasis, links and HTML.
+const markdownEscapeChars = "\\*_[]<>"
+
+// DescriptionMarkdown returns the given description as Markdown, according to
+// its kind. Plain descriptions are escaped, so they are rendered as written,
+// if enabled with the EscapePlainDescriptions option. Other descriptions,
+// including descriptions without a kind (e.g. of identity attributes), are
+// returned as is. Lines after the first line are prefixed with the given
+// indent, so multi-line descriptions continue the list entry they are written
+// in.
+func DescriptionMarkdown(description string, kind tfjson.SchemaDescriptionKind, indent string, opts Options) string {
+	desc := strings.TrimSpace(description)
+	if desc == "" {
+		return ""
+	}
+
+	lines := strings.Split(desc, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+
+		if opts.EscapePlainDescriptions && kind == tfjson.SchemaDescriptionKindPlain {
+			line = escapePlainLine(line)
+		}
+
+		if i > 0 && line != "" {
+			line = indent + line
+		}
+
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// escapePlainLine escapes a line of a plain description, including characters
+// which only have a meaning at the start of a line, such as headings and list
+// markers. Underscores within words (e.g. attribute names) cannot start
+// emphasis, so are not escaped. Code spans are commonly used in plain
+// descriptions to quote names and values, so are kept as is.
+func escapePlainLine(line string) string {
+	var b strings.Builder
+
+	runes := []rune(line)
+	start := true
+	code := false
+
+	for i, r := range runes {
+		switch {
+		case r == '`':
+			code = !code
+		case code:
+			// within code span
+		case start && strings.ContainsRune("#-+", r):
+			b.WriteRune('\\')
+		case r == '_' && i > 0 && i < len(runes)-1 && isWordRune(runes[i-1]) && isWordRune(runes[i+1]):
+			// intraword underscore
+		case strings.ContainsRune(markdownEscapeChars, r):
+			b.WriteRune('\\')
+		}
+
+		b.WriteRune(r)
+
+		if r != ' ' && r != '\t' {
+			start = false
+		}
+	}
+
+	return b.String()
+}
+
+// isWordRune returns true if the given rune is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// writeDescription writes the given description of a list entry, followed by
+// the given generated sentences, preceded by a space, if it is not empty.
+func writeDescription(w io.Writer, description string, kind tfjson.SchemaDescriptionKind, opts Options, sentences ...string) error {
+	desc := appendSentences(DescriptionMarkdown(description, kind, "  ", opts), sentences...)
+	if desc == "" {
+		return nil
+	}
+
+	_, err := io.WriteString(w, " "+desc)
+	return err
+}
+
+// appendSentences returns the given description followed by the given
+// generated sentences, ending the description with a period first if it does
+// not end a sentence, e.g. "Example name. Defaults to `x`.".
+func appendSentences(desc string, sentences ...string) string {
+	if len(sentences) == 0 {
+		return desc
+	}
+
+	if desc == "" {
+		return strings.Join(sentences, " ")
+	}
+
+	return endSentence(desc) + " " + strings.Join(sentences, " ")
+}
+
+// endSentence returns the given description ending with a period, unless it
+// already ends with punctuation ending a sentence or with a code block.
+func endSentence(desc string) string {
+	desc = strings.TrimRightFunc(desc, unicode.IsSpace)
+
+	if desc == "" || strings.HasSuffix(desc, "```") || strings.ContainsAny(desc[len(desc)-1:], ".!?:") {
+		return desc
+	}
+
+	return desc + "."
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestDescriptionMarkdown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		description string
+		kind        tfjson.SchemaDescriptionKind
+		indent      string
+		opts        schemamd.Options
+		expected    string
+	}{
+		"empty": {
+			description: " \n ",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			expected:    "",
+		},
+		"plain": {
+			description: "The name of the thing.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			expected:    "The name of the thing.",
+		},
+		"plain emphasis": {
+			description: "Matches *.example.com or _any_ name.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			opts:        schemamd.Options{EscapePlainDescriptions: true},
+			expected:    `Matches \*.example.com or \_any\_ name.`,
+		},
+		"plain intraword underscores": {
+			description: "Conflicts with resource_group_name.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			opts:        schemamd.Options{EscapePlainDescriptions: true},
+			expected:    "Conflicts with resource_group_name.",
+		},
+		"plain links and html": {
+			description: "Use [name] or <name>, see C:\\path.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			opts:        schemamd.Options{EscapePlainDescriptions: true},
+			expected:    "Use \\[name\\] or \\<name\\>, see C:\\\\path.",
+		},
+		"plain code spans": {
+			description: "Matches `*.example.com` or *.example.com.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			opts:        schemamd.Options{EscapePlainDescriptions: true},
+			expected:    "Matches `*.example.com` or \\*.example.com.",
+		},
+		"plain multi-line": {
+			description: "Either:\n- first\n\n# second",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			indent:      "  ",
+			opts:        schemamd.Options{EscapePlainDescriptions: true},
+			expected:    "Either:\n  \\- first\n\n  \\# second",
+		},
+		"plain unescaped": {
+			description: "Matches *.example.com or _any_ name.",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			expected:    "Matches *.example.com or _any_ name.",
+		},
+		"plain multi-line unescaped": {
+			description: "Either:\n- first\n\n# second",
+			kind:        tfjson.SchemaDescriptionKindPlain,
+			indent:      "  ",
+			expected:    "Either:\n  - first\n\n  # second",
+		},
+		"markdown": {
+			description: "Matches `*.example.com` or **any** name.",
+			kind:        tfjson.SchemaDescriptionKindMarkdown,
+			expected:    "Matches `*.example.com` or **any** name.",
+		},
+		"markdown multi-line": {
+			description: "Either:\n\n- `first`\n- `second`  \n",
+			kind:        tfjson.SchemaDescriptionKindMarkdown,
+			indent:      "  ",
+			expected:    "Either:\n\n  - `first`\n  - `second`",
+		},
+		"no kind": {
+			description: "Matches *.example.com.",
+			expected:    "Matches *.example.com.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemamd.DescriptionMarkdown(testCase.description, testCase.kind, testCase.indent, testCase.opts)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// defaultKind is the kind of schemas rendered without a kind option.
+const defaultKind = "resource"
+
+// withDefaultKind returns the given options with the given kind, if the
+// options have no kind.
+func withDefaultKind(opts Options, kind string) Options {
+	if opts.Kind == "" {
+		opts.Kind = kind
+	}
+
+	return opts
+}
+
+// isDefaultIDAttribute returns true if the given attribute is a top-level `id`
+// attribute without a description, which is documented in the Read-Only group
+// with a default description, unless disabled in the options.
+func isDefaultIDAttribute(parents []string, name string, att *tfjson.SchemaAttribute, opts Options) bool {
+	return !opts.DisableIDDefault && len(parents) == 0 && strings.ToLower(name) == "id" && att.Description == ""
+}
+
+// defaultIDAttribute returns the given attribute with the default description
+// of a top-level `id` attribute (e.g. "The ID of this data source.").
+func defaultIDAttribute(att *tfjson.SchemaAttribute, opts Options) *tfjson.SchemaAttribute {
+	result := *att
+	result.Description = opts.IDDescription
+
+	if result.Description == "" {
+		kind := opts.Kind
+		if kind == "" {
+			kind = defaultKind
+		}
+
+		result.Description = "The ID of this " + kind + "."
+	}
+
+	return &result
+}
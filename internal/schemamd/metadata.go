@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Metadata represents information about an attribute or block which is known
+// to the provider, but not part of the provider schema, such as default
+// values and validation constraints.
+type Metadata struct {
+	// Default, if set, is the default value, written as "Defaults to X.".
+	Default any `json:"default,omitempty"`
+
+	// OneOf, if set, are the allowed values, written as "Must be one of:
+	// X, Y.".
+	OneOf []any `json:"one_of,omitempty"`
+
+	// MinLength and MaxLength, if set, are the length constraints of a
+	// string value.
+	MinLength *int `json:"min_length,omitempty"`
+	MaxLength *int `json:"max_length,omitempty"`
+
+	// Pattern, if set, is the regular expression which a string value must
+	// match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// ConflictsWith, if set, are the paths of attributes and blocks which
+	// cannot be configured together with this attribute or block.
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+
+	// StateLocking and Workspaces, if set, declare that a root attribute of
+	// a state store configures state locking or workspaces. They are not
+	// written in the attribute description, but listed in the notes of the
+	// state store documentation.
+	StateLocking bool `json:"state_locking,omitempty"`
+	Workspaces   bool `json:"workspaces,omitempty"`
+}
+
+// metadata returns the metadata of the attribute or block at the given path,
+// if any.
+func (o Options) metadata(path []string) (Metadata, bool) {
+	md, ok := o.Metadata[strings.Join(path, ".")]
+	return md, ok
+}
+
+// metadataAnnotations returns the sentences describing the given metadata.
+func metadataAnnotations(md Metadata) ([]string, error) {
+	var annotations []string
+
+	if md.Default != nil {
+		value, err := metadataValue(md.Default)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write default value: %w", err)
+		}
+
+		annotations = append(annotations, "Defaults to "+value+".")
+	}
+
+	if len(md.OneOf) > 0 {
+		values := make([]string, 0, len(md.OneOf))
+		for _, v := range md.OneOf {
+			value, err := metadataValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to write allowed value: %w", err)
+			}
+
+			values = append(values, value)
+		}
+
+		annotations = append(annotations, "Must be one of: "+strings.Join(values, ", ")+".")
+	}
+
+	switch {
+	case md.MinLength != nil && md.MaxLength != nil && *md.MinLength == *md.MaxLength:
+		annotations = append(annotations, fmt.Sprintf("Must be exactly %d characters long.", *md.MinLength))
+	case md.MinLength != nil && md.MaxLength != nil:
+		annotations = append(annotations, fmt.Sprintf("Must be between %d and %d characters long.", *md.MinLength, *md.MaxLength))
+	case md.MinLength != nil:
+		annotations = append(annotations, fmt.Sprintf("Must be at least %d characters long.", *md.MinLength))
+	case md.MaxLength != nil:
+		annotations = append(annotations, fmt.Sprintf("Must be at most %d characters long.", *md.MaxLength))
+	}
+
+	if md.Pattern != "" {
+		annotations = append(annotations, "Must match the regular expression "+codeSpan(md.Pattern)+".")
+	}
+
+	if len(md.ConflictsWith) > 0 {
+		paths := make([]string, 0, len(md.ConflictsWith))
+		for _, path := range md.ConflictsWith {
+			paths = append(paths, codeSpan(path))
+		}
+
+		annotations = append(annotations, "Conflicts with "+strings.Join(paths, ", ")+".")
+	}
+
+	return annotations, nil
+}
+
+// metadataValue returns the given value as a code span, in the JSON syntax,
+// which matches the Terraform syntax of primitive values.
+func metadataValue(v any) (string, error) {
+	b := &strings.Builder{}
+
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(v)
+	if err != nil {
+		return "", err
+	}
+
+	return codeSpan(strings.TrimSuffix(b.String(), "\n")), nil
+}
+
+// codeSpan returns the given text as a Markdown code span, delimited by more
+// backticks than the text contains in a row.
+func codeSpan(text string) string {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r != '`' {
+			run = 0
+			continue
+		}
+
+		run++
+		longest = max(longest, run)
+	}
+
+	if longest == 0 {
+		return "`" + text + "`"
+	}
+
+	fence := strings.Repeat("`", longest+1)
+
+	return fence + " " + text + " " + fence
+}
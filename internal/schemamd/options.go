@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+// Options represents configuration options for rendering schemas. The zero
+// value renders schemas in the default format.
+type Options struct {
+	// TypeConstraints writes the Terraform type constraint syntax of each
+	// attribute (e.g. "list(object({ name = string }))") next to its type.
+	TypeConstraints bool
+
+	// EscapePlainDescriptions escapes descriptions of the plain kind, so
+	// characters with a meaning in Markdown (e.g. "*" and "_") are rendered as
+	// written, instead of writing them as is.
+	EscapePlainDescriptions bool
+
+	// WriteOnlyGroup writes write-only attributes in a separate "Write-Only"
+	// group, instead of the Required or Optional groups.
+	WriteOnlyGroup bool
+
+	// SensitiveGroup writes sensitive attributes in a separate "Sensitive"
+	// group, instead of the Required, Optional or Read-Only groups.
+	SensitiveGroup bool
+
+	// BlocksLast writes blocks after attributes within each group, instead of
+	// sorting them together by name.
+	BlocksLast bool
+
+	// Order is a list of attribute and block paths (e.g. "name" or
+	// "settings.port"), which are written first within each group, in the
+	// listed order. Other names are sorted alphabetically after them.
+	Order []string
+
+	// Kind is the kind of the rendered schema (e.g. "data source"), which is
+	// used in the default description of a top-level `id` attribute.
+	// Defaults to "resource", or the kind of the render function (e.g. "list
+	// resource" for RenderListResource).
+	Kind string
+
+	// IDDescription, if set, replaces the default description (e.g. "The ID
+	// of this data source.") of a top-level `id` attribute without a
+	// description.
+	IDDescription string
+
+	// DisableIDDefault documents a top-level `id` attribute without a
+	// description like every other attribute, instead of in the Read-Only
+	// group with a default description.
+	DisableIDDefault bool
+
+	// Overrides are documentation overrides of attributes and blocks, by
+	// path (e.g. "name" or "settings.port").
+	Overrides map[string]Override
+
+	// Metadata is information about attributes and blocks which is not part
+	// of the schema, such as default values and validation constraints, by
+	// path (e.g. "name" or "settings.port").
+	Metadata map[string]Metadata
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"io"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Override represents documentation overrides of an attribute or block, which
+// enrich the generated documentation without changing the provider schema.
+type Override struct {
+	// Description, if set, replaces the schema description. It is written as
+	// Markdown.
+	Description string
+
+	// Markdown, if set, is written after the description.
+	Markdown string
+
+	// Example, if set, is written as a Terraform configuration code block
+	// below the attribute or block.
+	Example string
+}
+
+// override returns the override of the attribute or block at the given path,
+// if any.
+func (o Options) override(path []string) (Override, bool) {
+	ov, ok := o.Overrides[strings.Join(path, ".")]
+	return ov, ok
+}
+
+// overrideAttribute returns the given attribute with the description of the
+// given override, if set.
+func overrideAttribute(att *tfjson.SchemaAttribute, ov Override) *tfjson.SchemaAttribute {
+	if ov.Description == "" {
+		return att
+	}
+
+	result := *att
+	result.Description = ov.Description
+	result.DescriptionKind = tfjson.SchemaDescriptionKindMarkdown
+
+	return &result
+}
+
+// overrideBlockType returns the given block type with the description of the
+// given override, if set.
+func overrideBlockType(block *tfjson.SchemaBlockType, ov Override) *tfjson.SchemaBlockType {
+	if ov.Description == "" {
+		return block
+	}
+
+	nestedBlock := *block.Block
+	nestedBlock.Description = ov.Description
+	nestedBlock.DescriptionKind = tfjson.SchemaDescriptionKindMarkdown
+
+	result := *block
+	result.Block = &nestedBlock
+
+	return &result
+}
+
+// writeOverrideMarkdown writes the additional Markdown of the given override
+// of a list entry, if set.
+func writeOverrideMarkdown(w io.Writer, ov Override) error {
+	return writeDescription(w, ov.Markdown, tfjson.SchemaDescriptionKindMarkdown, Options{})
+}
+
+// writeOverrideExample writes the example of the given override as a code
+// block continuing a list entry, if set.
+func writeOverrideExample(w io.Writer, ov Override) error {
+	example := strings.Trim(ov.Example, "\n")
+	if strings.TrimSpace(example) == "" {
+		return nil
+	}
+
+	lines := strings.Split(example, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+			continue
+		}
+
+		lines[i] = "  " + strings.TrimRight(line, " \t\r")
+	}
+
+	_, err := io.WriteString(w, "\n  ```terraform\n"+strings.Join(lines, "\n")+"\n  ```\n")
+	return err
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// HasPath returns true if the given block has an attribute or block at the
+// given path, as used in the Order, Overrides and Metadata options (e.g.
+// "settings.port", or "pair.0.name" for a tuple element).
+func HasPath(block *tfjson.SchemaBlock, path string) bool {
+	return blockHasPath(block, strings.Split(path, "."))
+}
+
+func blockHasPath(block *tfjson.SchemaBlock, path []string) bool {
+	if block == nil {
+		return false
+	}
+
+	if att, ok := block.Attributes[path[0]]; ok {
+		return attributeHasPath(att, path[1:])
+	}
+
+	if blockType, ok := block.NestedBlocks[path[0]]; ok {
+		return len(path) == 1 || blockHasPath(blockType.Block, path[1:])
+	}
+
+	return false
+}
+
+func attributeHasPath(att *tfjson.SchemaAttribute, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+
+	if att.AttributeNestedType != nil {
+		return blockHasPath(&tfjson.SchemaBlock{Attributes: att.AttributeNestedType.Attributes}, path)
+	}
+
+	return typeHasPath(att.AttributeType, path)
+}
+
+func typeHasPath(ty cty.Type, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+
+	ty = nestedObjectType(ty)
+
+	switch {
+	case ty.IsObjectType():
+		if !ty.HasAttribute(path[0]) {
+			return false
+		}
+
+		return typeHasPath(ty.AttributeType(path[0]), path[1:])
+	case ty.IsTupleType():
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(ty.TupleElementTypes()) {
+			return false
+		}
+
+		return typeHasPath(ty.TupleElementType(i), path[1:])
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestHasPath(t *testing.T) {
+	t.Parallel()
+
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"name": {
+				AttributeType: cty.String,
+			},
+			"ports": {
+				AttributeType: cty.List(cty.Object(map[string]cty.Type{
+					"number": cty.Number,
+				})),
+			},
+			"pair": {
+				AttributeType: cty.Tuple([]cty.Type{
+					cty.Object(map[string]cty.Type{
+						"name": cty.String,
+					}),
+					cty.String,
+				}),
+			},
+			"tags": {
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"key": {
+							AttributeType: cty.String,
+						},
+					},
+				},
+			},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"settings": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"port": {
+							AttributeType: cty.Number,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path     string
+		expected bool
+	}{
+		"attribute":                 {"name", true},
+		"block":                     {"settings", true},
+		"block attribute":           {"settings.port", true},
+		"nested attribute":          {"tags.key", true},
+		"object attribute":          {"ports.number", true},
+		"tuple element attribute":   {"pair.0.name", true},
+		"tuple element":             {"pair.1", true},
+		"unknown attribute":         {"nmae", false},
+		"unknown block attribute":   {"settings.host", false},
+		"unknown nested attribute":  {"tags.value", false},
+		"unknown tuple element":     {"pair.2", false},
+		"primitive attribute child": {"name.length", false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemamd.HasPath(block, testCase.path)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
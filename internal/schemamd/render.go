@@ -8,6 +8,7 @@ import (
 	"io"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	tfjson "github.com/hashicorp/terraform-json"
@@ -22,13 +23,13 @@ import (
 //	  },
 //		 "version": 0
 //	},
-func Render(schema *tfjson.Schema, w io.Writer) error {
+func Render(schema *tfjson.Schema, w io.Writer, opts Options) error {
 	_, err := io.WriteString(w, "## Schema\n\n")
 	if err != nil {
 		return err
 	}
 
-	err = writeRootBlock(w, schema.Block)
+	err = writeRootBlock(w, schema.Block, opts)
 	if err != nil {
 		return fmt.Errorf("unable to render schema: %w", err)
 	}
@@ -36,15 +37,48 @@ func Render(schema *tfjson.Schema, w io.Writer) error {
 	return nil
 }
 
+// RenderListResource is a variant of Render for list resource schemas. The list resource schema block is the
+// config block nested in a list block of a query configuration file (.tfquery.hcl).
+func RenderListResource(schema *tfjson.Schema, w io.Writer, opts Options) error {
+	_, err := io.WriteString(w, "## Schema\n\nThe following arguments are supported in the `config` block of the `list` block.\n\n")
+	if err != nil {
+		return err
+	}
+
+	err = writeRootBlock(w, schema.Block, withDefaultKind(opts, "list resource"))
+	if err != nil {
+		return fmt.Errorf("unable to render list resource schema: %w", err)
+	}
+
+	return nil
+}
+
+// RenderStateStore is a variant of Render for state store schemas. The state store schema block is the
+// state_store block nested in the terraform block of a configuration.
+func RenderStateStore(schema *tfjson.Schema, w io.Writer, opts Options) error {
+	_, err := io.WriteString(w, "## Schema\n\nThe following arguments are supported in the `state_store` block of the `terraform` block.\n\n")
+	if err != nil {
+		return err
+	}
+
+	err = writeRootBlock(w, schema.Block, withDefaultKind(opts, "state store"))
+	if err != nil {
+		return fmt.Errorf("unable to render state store schema: %w", err)
+	}
+
+	return nil
+}
+
 // RenderAction is a variant of Render for action schemas. Action schemas share the same config block as
-// resource schemas, with the addition of other schema data based on the type of action it is.
-func RenderAction(schema *tfjson.ActionSchema, w io.Writer) error {
+// resource schemas. The exported action schema contains no other data, how an action is triggered from a
+// resource lifecycle action_trigger block is documented by the action template instead.
+func RenderAction(schema *tfjson.ActionSchema, w io.Writer, opts Options) error {
 	_, err := io.WriteString(w, "## Schema\n\n")
 	if err != nil {
 		return err
 	}
 
-	err = writeRootBlock(w, schema.Block)
+	err = writeRootBlock(w, schema.Block, withDefaultKind(opts, "action"))
 	if err != nil {
 		return fmt.Errorf("unable to render action schema: %w", err)
 	}
@@ -52,13 +86,13 @@ func RenderAction(schema *tfjson.ActionSchema, w io.Writer) error {
 	return nil
 }
 
-func RenderIdentitySchema(identitySchema *tfjson.IdentitySchema, w io.Writer) error {
+func RenderIdentitySchema(identitySchema *tfjson.IdentitySchema, w io.Writer, opts Options) error {
 	_, err := io.WriteString(w, "### Identity Schema\n\n")
 	if err != nil {
 		return err
 	}
 
-	err = writeIdentitySchemaAttributes(w, identitySchema.Attributes)
+	err = writeIdentitySchemaAttributes(w, identitySchema.Attributes, opts)
 	if err != nil {
 		return fmt.Errorf("unable to render identity schema: %w", err)
 	}
@@ -66,7 +100,7 @@ func RenderIdentitySchema(identitySchema *tfjson.IdentitySchema, w io.Writer) er
 	return nil
 }
 
-func writeIdentitySchemaAttributes(w io.Writer, attrs map[string]*tfjson.IdentityAttribute) error {
+func writeIdentitySchemaAttributes(w io.Writer, attrs map[string]*tfjson.IdentityAttribute, opts Options) error {
 	attrNames := []string{}
 	for n := range attrs {
 		attrNames = append(attrNames, n)
@@ -102,7 +136,7 @@ func writeIdentitySchemaAttributes(w io.Writer, attrs map[string]*tfjson.Identit
 			}
 		}
 
-		err := writeIdentityAttribute(w, name, requiredAttr)
+		err := writeIdentityAttribute(w, name, requiredAttr, opts)
 		if err != nil {
 			return fmt.Errorf("unable to render identity attribute %q: %w", name, err)
 		}
@@ -122,7 +156,7 @@ func writeIdentitySchemaAttributes(w io.Writer, attrs map[string]*tfjson.Identit
 			}
 		}
 
-		err := writeIdentityAttribute(w, name, optionalAttr)
+		err := writeIdentityAttribute(w, name, optionalAttr, opts)
 		if err != nil {
 			return fmt.Errorf("unable to render identity attribute %q: %w", name, err)
 		}
@@ -131,7 +165,7 @@ func writeIdentitySchemaAttributes(w io.Writer, attrs map[string]*tfjson.Identit
 	return nil
 }
 
-func writeIdentityAttribute(w io.Writer, name string, attr *tfjson.IdentityAttribute) error {
+func writeIdentityAttribute(w io.Writer, name string, attr *tfjson.IdentityAttribute, opts Options) error {
 	_, err := io.WriteString(w, "- `"+name+"` ")
 	if err != nil {
 		return err
@@ -147,17 +181,19 @@ func writeIdentityAttribute(w io.Writer, name string, attr *tfjson.IdentityAttri
 		return err
 	}
 
+	err = writeTypeConstraintOption(w, attr.IdentityType, opts)
+	if err != nil {
+		return err
+	}
+
 	_, err = io.WriteString(w, ")")
 	if err != nil {
 		return err
 	}
 
-	desc := strings.TrimSpace(attr.Description)
-	if desc != "" {
-		_, err = io.WriteString(w, " "+desc)
-		if err != nil {
-			return err
-		}
+	err = writeDescription(w, attr.Description, "", opts)
+	if err != nil {
+		return err
 	}
 
 	_, err = io.WriteString(w, "\n")
@@ -172,9 +208,14 @@ func writeIdentityAttribute(w io.Writer, name string, attr *tfjson.IdentityAttri
 type groupFilter struct {
 	topLevelTitle string
 	nestedTitle   string
+	requiredness  string
 
 	filterAttribute func(att *tfjson.SchemaAttribute) bool
 	filterBlock     func(block *tfjson.SchemaBlockType) bool
+
+	// override is true for optional groups, which attributes and blocks are
+	// matched against before the Required, Optional and Read-Only groups.
+	override bool
 }
 
 var (
@@ -183,12 +224,84 @@ var (
 	// * Optional
 	// * Read-Only
 	groupFilters = []groupFilter{
-		{"### Required", "Required:", childAttributeIsRequired, childBlockIsRequired},
-		{"### Optional", "Optional:", childAttributeIsOptional, childBlockIsOptional},
-		{"### Read-Only", "Read-Only:", childAttributeIsReadOnly, childBlockIsReadOnly},
+		{"### Required", "Required:", "Required", childAttributeIsRequired, childBlockIsRequired, false},
+		{"### Optional", "Optional:", "Optional", childAttributeIsOptional, childBlockIsOptional, false},
+		{"### Read-Only", "Read-Only:", "Read-only", childAttributeIsReadOnly, childBlockIsReadOnly, false},
 	}
+
+	// Optional groups, enabled with Options.WriteOnlyGroup and
+	// Options.SensitiveGroup, are written between the Optional and Read-Only
+	// groups.
+	writeOnlyGroupFilter = groupFilter{"### Write-Only", "Write-Only:", "Write-only", childAttributeIsWriteOnly, noBlock, true}
+	sensitiveGroupFilter = groupFilter{"### Sensitive", "Sensitive:", "Sensitive", childAttributeIsSensitive, noBlock, true}
 )
 
+// groupFiltersFor returns the group filters enabled by the given options, in
+// the order the groups are written.
+func groupFiltersFor(opts Options) []groupFilter {
+	result := []groupFilter{groupFilters[0], groupFilters[1]}
+
+	if opts.WriteOnlyGroup {
+		result = append(result, writeOnlyGroupFilter)
+	}
+
+	if opts.SensitiveGroup {
+		result = append(result, sensitiveGroupFilter)
+	}
+
+	return append(result, groupFilters[2])
+}
+
+// groupMatchOrder returns the indexes of the given group filters in the order
+// attributes and blocks are matched against them.
+func groupMatchOrder(filters []groupFilter) []int {
+	result := make([]int, 0, len(filters))
+
+	for i, gf := range filters {
+		if gf.override {
+			result = append(result, i)
+		}
+	}
+
+	for i, gf := range filters {
+		if !gf.override {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+// sortNames sorts the given attribute and block names of the given parents.
+// Names listed in Options.Order are sorted first, in the listed order, then
+// attributes before blocks if Options.BlocksLast is enabled, then names are
+// sorted alphabetically.
+func sortNames(names []string, parents []string, isBlock func(name string) bool, opts Options) {
+	priority := func(name string) int {
+		path := strings.Join(append(slices.Clone(parents), name), ".")
+
+		if i := slices.Index(opts.Order, path); i >= 0 {
+			return i
+		}
+
+		return len(opts.Order)
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		if pi, pj := priority(names[i]), priority(names[j]); pi != pj {
+			return pi < pj
+		}
+
+		if opts.BlocksLast {
+			if bi, bj := isBlock(names[i]), isBlock(names[j]); bi != bj {
+				return bj
+			}
+		}
+
+		return names[i] < names[j]
+	})
+}
+
 type nestedType struct {
 	anchorID  string
 	pathTitle string
@@ -198,30 +311,150 @@ type nestedType struct {
 	attrs     *tfjson.SchemaNestedAttributeType
 
 	group groupFilter
+
+	// summary is the type, requiredness, cardinality and description of the
+	// parent entry, written at the top of the nested schema section.
+	summary string
+}
+
+// parentAnchorID returns the ID of the anchor of the parent entry of the
+// nested type with the given anchor ID, which the nested schema section links
+// back to.
+func parentAnchorID(anchorID string) string {
+	return "parent--" + anchorID
+}
+
+// writeEntryName writes the start of the list entry of the given name, with an
+// anchor the nested schema section links back to, if the entry has one.
+func writeEntryName(w io.Writer, name, anchorID string, nested bool) error {
+	if !nested {
+		_, err := io.WriteString(w, "- `"+name+"` ")
+		return err
+	}
+
+	_, err := io.WriteString(w, "- <a id=\""+parentAnchorID(anchorID)+"\"></a>`"+name+"` ")
+	return err
 }
 
-func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, group groupFilter) ([]nestedType, error) {
+// nestedTypeSummary returns the summary of a nested type, for example
+// "(Block List, Required, Min: 1) Description of the block.". The minimum and
+// maximum number of items are omitted for single nesting modes, where they are
+// implied by the requiredness.
+func nestedTypeSummary(kind, requiredness string, nestingMode tfjson.SchemaNestingMode, minItems, maxItems uint64, description string, descriptionKind tfjson.SchemaDescriptionKind, opts Options) string {
+	var b strings.Builder
+
+	b.WriteString("(" + kind + ", " + requiredness)
+
+	if nestingMode != tfjson.SchemaNestingModeSingle {
+		if minItems > 0 {
+			b.WriteString(fmt.Sprintf(", Min: %d", minItems))
+		}
+
+		if maxItems > 0 {
+			b.WriteString(fmt.Sprintf(", Max: %d", maxItems))
+		}
+	}
+
+	b.WriteString(")")
+
+	desc := DescriptionMarkdown(description, descriptionKind, "", opts)
+	if desc != "" {
+		b.WriteString(" " + desc)
+	}
+
+	return b.String()
+}
+
+// nestingModeKind returns the kind of a nested block or nested attribute type
+// with the given nesting mode (e.g. "Block List" or "Attributes").
+func nestingModeKind(prefix string, nestingMode tfjson.SchemaNestingMode) (string, error) {
+	switch nestingMode {
+	case tfjson.SchemaNestingModeSingle:
+		return prefix, nil
+	case tfjson.SchemaNestingModeList:
+		return prefix + " List", nil
+	case tfjson.SchemaNestingModeSet:
+		return prefix + " Set", nil
+	case tfjson.SchemaNestingModeMap:
+		return prefix + " Map", nil
+	}
+
+	return "", fmt.Errorf("unexpected nesting mode: %s", nestingMode)
+}
+
+// attributeRequiredness returns the requiredness of the given attribute, as
+// written in nested schema summaries.
+func attributeRequiredness(att *tfjson.SchemaAttribute) string {
+	for _, gf := range groupFilters {
+		if gf.filterAttribute(att) {
+			return gf.requiredness
+		}
+	}
+
+	return ""
+}
+
+// blockRequiredness returns the requiredness of the given block, as written in
+// nested schema summaries.
+func blockRequiredness(block *tfjson.SchemaBlockType) string {
+	for _, gf := range groupFilters {
+		if gf.filterBlock(block) {
+			return gf.requiredness
+		}
+	}
+
+	return ""
+}
+
+// typeSummary returns the summary of a nested object, tuple or collection of
+// them, for example "(List of Object, Optional) Description of the attribute.".
+func typeSummary(ty cty.Type, requiredness, description string, descriptionKind tfjson.SchemaDescriptionKind, opts Options) (string, error) {
+	var b strings.Builder
+
+	err := WriteType(&b, ty)
+	if err != nil {
+		return "", err
+	}
+
+	return nestedTypeSummary(b.String(), requiredness, tfjson.SchemaNestingModeSingle, 0, 0, description, descriptionKind, opts), nil
+}
+
+func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, group groupFilter, opts Options) ([]nestedType, error) {
 	name := path[len(path)-1]
+	anchorID := "nestedatt--" + strings.Join(path, "--")
 
-	_, err := io.WriteString(w, "- `"+name+"` ")
+	ov, _ := opts.override(path)
+	att = overrideAttribute(att, ov)
+
+	err := writeEntryName(w, name, anchorID, att.AttributeNestedType != nil || isNestedObjectType(att.AttributeType))
 	if err != nil {
 		return nil, err
 	}
 
+	md, _ := opts.metadata(path)
+
+	annotations, err := metadataAnnotations(md)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write metadata for %q: %w", name, err)
+	}
+
+	// Override groups (e.g. "Sensitive") contain attributes of every
+	// requiredness, so it is written in the entry instead.
 	if att.AttributeNestedType == nil {
-		err = WriteAttributeDescription(w, att, false)
+		err = writeAttributeDescription(w, att, group.override, opts, annotations)
 	} else {
-		err = WriteNestedAttributeTypeDescription(w, att, false)
+		err = writeNestedAttributeTypeDescription(w, att, group.override, opts, annotations)
 	}
 	if err != nil {
 		return nil, err
 	}
-	if att.AttributeType.IsTupleType() {
-		return nil, fmt.Errorf("TODO: tuples are not yet supported")
+
+	err = writeOverrideMarkdown(w, ov)
+	if err != nil {
+		return nil, err
 	}
 
-	anchorID := "nestedatt--" + strings.Join(path, "--")
-	pathTitle := strings.Join(path, ".")
+	pathTitle := joinPath(path)
 	nestedTypes := []nestedType{}
 	switch {
 	case att.AttributeNestedType != nil:
@@ -230,6 +463,11 @@ func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, gro
 			return nil, err
 		}
 
+		kind, err := nestingModeKind("Attributes", att.AttributeNestedType.NestingMode)
+		if err != nil {
+			return nil, err
+		}
+
 		nestedTypes = append(nestedTypes, nestedType{
 			anchorID:  anchorID,
 			pathTitle: pathTitle,
@@ -237,28 +475,21 @@ func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, gro
 			attrs:     att.AttributeNestedType,
 
 			group: group,
+
+			summary: nestedTypeSummary(kind, attributeRequiredness(att), att.AttributeNestedType.NestingMode, att.AttributeNestedType.MinItems, att.AttributeNestedType.MaxItems, att.Description, att.DescriptionKind, opts),
 		})
-	case att.AttributeType.IsObjectType():
+	case isNestedObjectType(att.AttributeType):
 		_, err = io.WriteString(w, " (see [below for nested schema](#"+anchorID+"))")
 		if err != nil {
 			return nil, err
 		}
 
-		nestedTypes = append(nestedTypes, nestedType{
-			anchorID:  anchorID,
-			pathTitle: pathTitle,
-			path:      path,
-			object:    &att.AttributeType,
-
-			group: group,
-		})
-	case att.AttributeType.IsCollectionType() && att.AttributeType.ElementType().IsObjectType():
-		_, err = io.WriteString(w, " (see [below for nested schema](#"+anchorID+"))")
+		summary, err := typeSummary(att.AttributeType, attributeRequiredness(att), att.Description, att.DescriptionKind, opts)
 		if err != nil {
 			return nil, err
 		}
 
-		nt := att.AttributeType.ElementType()
+		nt := nestedObjectType(att.AttributeType)
 		nestedTypes = append(nestedTypes, nestedType{
 			anchorID:  anchorID,
 			pathTitle: pathTitle,
@@ -266,6 +497,8 @@ func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, gro
 			object:    &nt,
 
 			group: group,
+
+			summary: summary,
 		})
 	}
 
@@ -274,29 +507,56 @@ func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, gro
 		return nil, err
 	}
 
+	err = writeOverrideExample(w, ov)
+	if err != nil {
+		return nil, err
+	}
+
 	return nestedTypes, nil
 }
 
-func writeBlockType(w io.Writer, path []string, block *tfjson.SchemaBlockType) ([]nestedType, error) {
+func writeBlockType(w io.Writer, path []string, block *tfjson.SchemaBlockType, opts Options) ([]nestedType, error) {
 	name := path[len(path)-1]
+	anchorID := "nestedblock--" + strings.Join(path, "--")
 
-	_, err := io.WriteString(w, "- `"+name+"` ")
+	ov, _ := opts.override(path)
+	block = overrideBlockType(block, ov)
+
+	err := writeEntryName(w, name, anchorID, true)
 	if err != nil {
 		return nil, err
 	}
 
-	err = WriteBlockTypeDescription(w, block)
+	md, _ := opts.metadata(path)
+
+	annotations, err := metadataAnnotations(md)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write metadata for %q: %w", name, err)
+	}
+
+	err = writeBlockTypeDescription(w, block, opts, annotations)
 	if err != nil {
 		return nil, fmt.Errorf("unable to write block description for %q: %w", name, err)
 	}
 
-	anchorID := "nestedblock--" + strings.Join(path, "--")
+	err = writeOverrideMarkdown(w, ov)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := nestingModeKind("Block", block.NestingMode)
+	if err != nil {
+		return nil, err
+	}
+
 	pathTitle := strings.Join(path, ".")
 	nt := nestedType{
 		anchorID:  anchorID,
 		pathTitle: pathTitle,
 		path:      path,
 		block:     block.Block,
+
+		summary: nestedTypeSummary(kind, blockRequiredness(block), block.NestingMode, block.MinItems, block.MaxItems, block.Block.Description, block.Block.DescriptionKind, opts),
 	}
 
 	_, err = io.WriteString(w, " (see [below for nested schema](#"+anchorID+"))")
@@ -309,11 +569,16 @@ func writeBlockType(w io.Writer, path []string, block *tfjson.SchemaBlockType) (
 		return nil, err
 	}
 
+	err = writeOverrideExample(w, ov)
+	if err != nil {
+		return nil, err
+	}
+
 	return []nestedType{nt}, nil
 }
 
-func writeRootBlock(w io.Writer, block *tfjson.SchemaBlock) error {
-	return writeBlockChildren(w, nil, block, true)
+func writeRootBlock(w io.Writer, block *tfjson.SchemaBlock, opts Options) error {
+	return writeBlockChildren(w, nil, block, true, opts)
 }
 
 // A Block contains:
@@ -343,7 +608,7 @@ func writeRootBlock(w io.Writer, block *tfjson.SchemaBlock) error {
 //		 },
 //		 "description_kind": "plain"
 //	},
-func writeBlockChildren(w io.Writer, parents []string, block *tfjson.SchemaBlock, root bool) error {
+func writeBlockChildren(w io.Writer, parents []string, block *tfjson.SchemaBlock, root bool, opts Options) error {
 	names := []string{}
 	for n := range block.Attributes {
 		names = append(names, n)
@@ -352,28 +617,31 @@ func writeBlockChildren(w io.Writer, parents []string, block *tfjson.SchemaBlock
 		names = append(names, n)
 	}
 
+	filters := groupFiltersFor(opts)
 	groups := map[int][]string{}
 
 	// Group Attributes/Blocks by characteristics.
 nameLoop:
 	for _, n := range names {
 		if childBlock, ok := block.NestedBlocks[n]; ok {
-			for i, gf := range groupFilters {
+			for _, i := range groupMatchOrder(filters) {
+				gf := filters[i]
 				if gf.filterBlock(childBlock) {
 					groups[i] = append(groups[i], n)
 					continue nameLoop
 				}
 			}
 		} else if childAtt, ok := block.Attributes[n]; ok {
-			for i, gf := range groupFilters {
+			for _, i := range groupMatchOrder(filters) {
+				gf := filters[i]
 				// By default, the attribute `id` is place in the "Read-Only" group
 				// if the provider schema contained no `.Description` for it.
 				//
-				// If a `.Description` is provided instead, the behaviour will be the
-				// same as for every other attribute.
-				if strings.ToLower(n) == "id" && len(parents) == 0 && childAtt.Description == "" {
+				// If a `.Description` is provided instead, or the default is
+				// disabled, the behaviour will be the same as for every other
+				// attribute.
+				if isDefaultIDAttribute(parents, n, childAtt, opts) {
 					if strings.Contains(gf.topLevelTitle, "Read-Only") {
-						childAtt.Description = "The ID of this resource."
 						groups[i] = append(groups[i], n)
 						continue nameLoop
 					}
@@ -420,12 +688,15 @@ nameLoop:
 	//       Recursively do nested type functionality
 	//   End
 	// End
-	for i, gf := range groupFilters {
+	for i, gf := range filters {
 		sortedNames := groups[i]
 		if len(sortedNames) == 0 {
 			continue
 		}
-		sort.Strings(sortedNames)
+		sortNames(sortedNames, parents, func(name string) bool {
+			_, ok := block.NestedBlocks[name]
+			return ok
+		}, opts)
 
 		groupTitle := gf.topLevelTitle
 		if !root {
@@ -465,7 +736,7 @@ nameLoop:
 			path = append(path, name)
 
 			if childBlock, ok := block.NestedBlocks[name]; ok {
-				nt, err := writeBlockType(w, path, childBlock)
+				nt, err := writeBlockType(w, path, childBlock, opts)
 				if err != nil {
 					return fmt.Errorf("unable to render block %q: %w", name, err)
 				}
@@ -473,7 +744,11 @@ nameLoop:
 				nestedTypes = append(nestedTypes, nt...)
 				continue
 			} else if childAtt, ok := block.Attributes[name]; ok {
-				nt, err := writeAttribute(w, path, childAtt, gf)
+				if isDefaultIDAttribute(parents, name, childAtt, opts) {
+					childAtt = defaultIDAttribute(childAtt, opts)
+				}
+
+				nt, err := writeAttribute(w, path, childAtt, gf, opts)
 				if err != nil {
 					return fmt.Errorf("unable to render attribute %q: %w", name, err)
 				}
@@ -491,7 +766,7 @@ nameLoop:
 		}
 	}
 
-	err := writeNestedTypes(w, nestedTypes)
+	err := writeNestedTypes(w, nestedTypes, opts)
 	if err != nil {
 		return err
 	}
@@ -499,7 +774,7 @@ nameLoop:
 	return nil
 }
 
-func writeNestedTypes(w io.Writer, nestedTypes []nestedType) error {
+func writeNestedTypes(w io.Writer, nestedTypes []nestedType, opts Options) error {
 	for _, nt := range nestedTypes {
 		_, err := io.WriteString(w, "<a id=\""+nt.anchorID+"\"></a>\n")
 		if err != nil {
@@ -511,19 +786,34 @@ func writeNestedTypes(w io.Writer, nestedTypes []nestedType) error {
 			return err
 		}
 
+		_, err = io.WriteString(w, nt.summary+"\n\n")
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "[Back to `"+nt.pathTitle+"`](#"+parentAnchorID(nt.anchorID)+")\n\n")
+		if err != nil {
+			return err
+		}
+
 		switch {
 		case nt.block != nil:
-			err = writeBlockChildren(w, nt.path, nt.block, false)
+			err = writeBlockChildren(w, nt.path, nt.block, false, opts)
+			if err != nil {
+				return err
+			}
+		case nt.object != nil && nt.object.IsTupleType():
+			err = writeTupleChildren(w, nt.path, *nt.object, nt.group, opts)
 			if err != nil {
 				return err
 			}
 		case nt.object != nil:
-			err = writeObjectChildren(w, nt.path, *nt.object, nt.group)
+			err = writeObjectChildren(w, nt.path, *nt.object, nt.group, opts)
 			if err != nil {
 				return err
 			}
 		case nt.attrs != nil:
-			err = writeNestedAttributeChildren(w, nt.path, nt.attrs, nt.group)
+			err = writeNestedAttributeChildren(w, nt.path, nt.attrs, nt.group, opts)
 			if err != nil {
 				return err
 			}
@@ -540,10 +830,16 @@ func writeNestedTypes(w io.Writer, nestedTypes []nestedType) error {
 	return nil
 }
 
-func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupFilter) ([]nestedType, error) {
-	name := path[len(path)-1]
+func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupFilter, opts Options) ([]nestedType, error) {
+	name := pathName(path)
+	anchorID := "nestedobjatt--" + strings.Join(path, "--")
+
+	err := writeEntryName(w, name, anchorID, isNestedObjectType(att))
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := io.WriteString(w, "- `"+name+"` (")
+	_, err = io.WriteString(w, "(")
 	if err != nil {
 		return nil, err
 	}
@@ -553,40 +849,51 @@ func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupF
 		return nil, err
 	}
 
+	err = writeTypeConstraintOption(w, att, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = io.WriteString(w, ")")
 	if err != nil {
 		return nil, err
 	}
 
-	if att.IsTupleType() {
-		return nil, fmt.Errorf("TODO: tuples are not yet supported")
+	// Object attributes have no schema description, but can be described
+	// with an override.
+	ov, _ := opts.override(path)
+
+	md, _ := opts.metadata(path)
+
+	annotations, err := metadataAnnotations(md)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write metadata for %q: %w", name, err)
+	}
+
+	err = writeDescription(w, ov.Description, tfjson.SchemaDescriptionKindMarkdown, opts, annotations...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeOverrideMarkdown(w, ov)
+	if err != nil {
+		return nil, err
 	}
 
-	anchorID := "nestedobjatt--" + strings.Join(path, "--")
-	pathTitle := strings.Join(path, ".")
+	pathTitle := joinPath(path)
 	nestedTypes := []nestedType{}
-	switch {
-	case att.IsObjectType():
+	if isNestedObjectType(att) {
 		_, err = io.WriteString(w, " (see [below for nested schema](#"+anchorID+"))")
 		if err != nil {
 			return nil, err
 		}
 
-		nestedTypes = append(nestedTypes, nestedType{
-			anchorID:  anchorID,
-			pathTitle: pathTitle,
-			path:      path,
-			object:    &att,
-
-			group: group,
-		})
-	case att.IsCollectionType() && att.ElementType().IsObjectType():
-		_, err = io.WriteString(w, " (see [below for nested schema](#"+anchorID+"))")
+		summary, err := typeSummary(att, group.requiredness, ov.Description, tfjson.SchemaDescriptionKindMarkdown, opts)
 		if err != nil {
 			return nil, err
 		}
 
-		nt := att.ElementType()
+		nt := nestedObjectType(att)
 		nestedTypes = append(nestedTypes, nestedType{
 			anchorID:  anchorID,
 			pathTitle: pathTitle,
@@ -594,6 +901,8 @@ func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupF
 			object:    &nt,
 
 			group: group,
+
+			summary: summary,
 		})
 	}
 
@@ -602,10 +911,15 @@ func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupF
 		return nil, err
 	}
 
+	err = writeOverrideExample(w, ov)
+	if err != nil {
+		return nil, err
+	}
+
 	return nestedTypes, nil
 }
 
-func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group groupFilter) error {
+func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group groupFilter, opts Options) error {
 	_, err := io.WriteString(w, group.nestedTitle+"\n\n")
 	if err != nil {
 		return err
@@ -616,7 +930,7 @@ func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group group
 	for n := range atts {
 		sortedNames = append(sortedNames, n)
 	}
-	sort.Strings(sortedNames)
+	sortNames(sortedNames, parents, noAttributeBlock, opts)
 	nestedTypes := []nestedType{}
 
 	for _, name := range sortedNames {
@@ -625,7 +939,7 @@ func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group group
 		copy(path, parents)
 		path = append(path, name)
 
-		nt, err := writeObjectAttribute(w, path, att, group)
+		nt, err := writeObjectAttribute(w, path, att, group, opts)
 		if err != nil {
 			return fmt.Errorf("unable to render attribute %q: %w", name, err)
 		}
@@ -638,7 +952,41 @@ func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group group
 		return err
 	}
 
-	err = writeNestedTypes(w, nestedTypes)
+	err = writeNestedTypes(w, nestedTypes, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func writeTupleChildren(w io.Writer, parents []string, ty cty.Type, group groupFilter, opts Options) error {
+	_, err := io.WriteString(w, group.nestedTitle+"\n\n")
+	if err != nil {
+		return err
+	}
+
+	nestedTypes := []nestedType{}
+
+	for i, elem := range ty.TupleElementTypes() {
+		path := make([]string, len(parents), len(parents)+1)
+		copy(path, parents)
+		path = append(path, strconv.Itoa(i))
+
+		nt, err := writeObjectAttribute(w, path, elem, group, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render tuple element %d: %w", i, err)
+		}
+
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return err
+	}
+
+	err = writeNestedTypes(w, nestedTypes, opts)
 	if err != nil {
 		return err
 	}
@@ -646,27 +994,91 @@ func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group group
 	return nil
 }
 
-func writeNestedAttributeChildren(w io.Writer, parents []string, nestedAttributes *tfjson.SchemaNestedAttributeType, group groupFilter) error {
+// isNestedObjectType returns true if the type has a nested schema: an object,
+// a tuple with an element with a nested schema, or a collection of them.
+func isNestedObjectType(ty cty.Type) bool {
+	ty = nestedObjectType(ty)
+
+	switch {
+	case ty.IsObjectType():
+		return true
+	case ty.IsTupleType():
+		for _, elem := range ty.TupleElementTypes() {
+			if isNestedObjectType(elem) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// nestedObjectType returns the element type of a collection type, otherwise
+// the type itself.
+func nestedObjectType(ty cty.Type) cty.Type {
+	if ty.IsCollectionType() {
+		return ty.ElementType()
+	}
+
+	return ty
+}
+
+// joinPath returns the title of the given path, where tuple element indexes
+// are written as "[0]" instead of ".0" (e.g. "pair[0].name").
+func joinPath(path []string) string {
+	var b strings.Builder
+
+	for i, name := range path {
+		if _, err := strconv.Atoi(name); err == nil {
+			b.WriteString("[" + name + "]")
+			continue
+		}
+
+		if i > 0 {
+			b.WriteString(".")
+		}
+
+		b.WriteString(name)
+	}
+
+	return b.String()
+}
+
+// pathName returns the name of the last element of the given path, where a
+// tuple element index is written as "[0]".
+func pathName(path []string) string {
+	name := path[len(path)-1]
+
+	if _, err := strconv.Atoi(name); err == nil {
+		return "[" + name + "]"
+	}
+
+	return name
+}
+
+func writeNestedAttributeChildren(w io.Writer, parents []string, nestedAttributes *tfjson.SchemaNestedAttributeType, group groupFilter, opts Options) error {
 	sortedNames := []string{}
 	for n := range nestedAttributes.Attributes {
 		sortedNames = append(sortedNames, n)
 	}
-	sort.Strings(sortedNames)
+	sortNames(sortedNames, parents, noAttributeBlock, opts)
 
+	filters := groupFiltersFor(opts)
 	groups := map[int][]string{}
 	for _, name := range sortedNames {
 		att := nestedAttributes.Attributes[name]
 
-		for i, gf := range groupFilters {
-			if gf.filterAttribute(att) {
+		for _, i := range groupMatchOrder(filters) {
+			if filters[i].filterAttribute(att) {
 				groups[i] = append(groups[i], name)
+				break
 			}
 		}
 	}
 
 	nestedTypes := []nestedType{}
 
-	for i, gf := range groupFilters {
+	for i, gf := range filters {
 		names, ok := groups[i]
 		if !ok || len(names) == 0 {
 			continue
@@ -683,7 +1095,7 @@ func writeNestedAttributeChildren(w io.Writer, parents []string, nestedAttribute
 			copy(path, parents)
 			path = append(path, name)
 
-			nt, err := writeAttribute(w, path, att, group)
+			nt, err := writeAttribute(w, path, att, group, opts)
 			if err != nil {
 				return fmt.Errorf("unable to render attribute %q: %w", name, err)
 			}
@@ -697,7 +1109,7 @@ func writeNestedAttributeChildren(w io.Writer, parents []string, nestedAttribute
 		}
 	}
 
-	err := writeNestedTypes(w, nestedTypes)
+	err := writeNestedTypes(w, nestedTypes, opts)
 	if err != nil {
 		return err
 	}
@@ -5,12 +5,14 @@ package schemamd_test
 
 import (
 	"encoding/json"
+	"io"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
 )
@@ -59,6 +61,21 @@ func TestRender(t *testing.T) {
 			"testdata/deep_nested_write_only_attributes.schema.json",
 			"testdata/deep_nested_write_only_attributes.md",
 		},
+		{
+			"tuple_types",
+			"testdata/tuple_types.schema.json",
+			"testdata/tuple_types.md",
+		},
+		{
+			"dynamic_types",
+			"testdata/type_constraints.schema.json",
+			"testdata/dynamic_types.md",
+		},
+		{
+			"description_kinds",
+			"testdata/description_kinds.schema.json",
+			"testdata/description_kinds.md",
+		},
 	} {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
@@ -81,7 +98,145 @@ func TestRender(t *testing.T) {
 			}
 
 			b := &strings.Builder{}
-			err = schemamd.Render(&schema, b)
+			err = schemamd.Render(&schema, b, schemamd.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Remove \r characters so tests don't fail on windows
+			expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+			// Remove trailing newlines before comparing (some text editors remove them).
+			expectedStr = strings.TrimRight(expectedStr, "\n")
+			actual := strings.TrimRight(b.String(), "\n")
+			if diff := cmp.Diff(expectedStr, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRender_TypeConstraints(t *testing.T) {
+	t.Parallel()
+
+	input, err := os.ReadFile("testdata/type_constraints.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("testdata/type_constraints.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema tfjson.Schema
+
+	err = json.Unmarshal(input, &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &strings.Builder{}
+	err = schemamd.Render(&schema, b, schemamd.Options{TypeConstraints: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(b.String(), "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRender_EscapePlainDescriptions(t *testing.T) {
+	t.Parallel()
+
+	input, err := os.ReadFile("testdata/description_kinds.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("testdata/description_kinds_escaped.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema tfjson.Schema
+
+	err = json.Unmarshal(input, &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &strings.Builder{}
+	err = schemamd.Render(&schema, b, schemamd.Options{EscapePlainDescriptions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(b.String(), "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRender_Grouping(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name         string
+		opts         schemamd.Options
+		expectedFile string
+	}{
+		{
+			"groups",
+			schemamd.Options{
+				WriteOnlyGroup: true,
+				SensitiveGroup: true,
+			},
+			"testdata/grouping_groups.md",
+		},
+		{
+			"ordering",
+			schemamd.Options{
+				BlocksLast: true,
+				Order:      []string{"zone", "settings.port"},
+			},
+			"testdata/grouping_ordering.md",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile("testdata/grouping.schema.json")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, err := os.ReadFile(c.expectedFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schema tfjson.Schema
+
+			err = json.Unmarshal(input, &schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &strings.Builder{}
+			err = schemamd.Render(&schema, b, c.opts)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -99,6 +254,221 @@ func TestRender(t *testing.T) {
 	}
 }
 
+func TestRender_Overrides(t *testing.T) {
+	t.Parallel()
+
+	input, err := os.ReadFile("testdata/overrides.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("testdata/overrides.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema tfjson.Schema
+
+	err = json.Unmarshal(input, &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := schemamd.Options{
+		Overrides: map[string]schemamd.Override{
+			"id": {
+				Description: "The ID of the resource, in the format `<region>/<name>`.",
+			},
+			"name": {
+				Markdown: "Changing the name forces a new resource to be created.",
+				Example:  "name = \"example\"\n",
+			},
+			"endpoint.host": {
+				Description: "Host name of the endpoint.",
+			},
+			"settings": {
+				Description: "Settings of the resource, see the [settings guide](../guides/settings.md).",
+				Example:     "settings {\n  port = 8080\n}\n",
+			},
+			"settings.port": {
+				Markdown: "Defaults to `443`.",
+			},
+		},
+	}
+
+	b := &strings.Builder{}
+	err = schemamd.Render(&schema, b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(b.String(), "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRender_Metadata(t *testing.T) {
+	t.Parallel()
+
+	input, err := os.ReadFile("testdata/metadata.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("testdata/metadata.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema tfjson.Schema
+
+	err = json.Unmarshal(input, &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	minLength, maxLength := 1, 63
+	opts := schemamd.Options{
+		Metadata: map[string]schemamd.Metadata{
+			"name": {
+				MinLength: &minLength,
+				MaxLength: &maxLength,
+				Pattern:   "^[a-z][a-z0-9-]*$",
+			},
+			"mode": {
+				Default: "standard",
+				OneOf:   []any{"standard", "<none>"},
+			},
+			"endpoint": {
+				ConflictsWith: []string{"settings"},
+			},
+			"endpoint.host": {
+				Default: "localhost",
+			},
+			"settings": {
+				ConflictsWith: []string{"endpoint"},
+			},
+			"settings.port": {
+				Default: 443,
+				OneOf:   []any{443, 8443},
+			},
+		},
+		Overrides: map[string]schemamd.Override{
+			"mode": {
+				Markdown: "See the [modes guide](../guides/modes.md).",
+			},
+		},
+	}
+
+	b := &strings.Builder{}
+	err = schemamd.Render(&schema, b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(b.String(), "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestRender_IDAttribute(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"id": {
+					AttributeType: cty.String,
+					Optional:      true,
+					Computed:      true,
+				},
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+				},
+			},
+		},
+	}
+
+	// The default description must not change the given schema.
+	t.Cleanup(func() {
+		if desc := schema.Block.Attributes["id"].Description; desc != "" {
+			t.Errorf("unexpected id attribute description in schema: %q", desc)
+		}
+	})
+
+	for _, c := range []struct {
+		name     string
+		render   func(*tfjson.Schema, io.Writer, schemamd.Options) error
+		opts     schemamd.Options
+		expected string
+	}{
+		{
+			"default",
+			schemamd.Render,
+			schemamd.Options{},
+			"## Schema\n\n### Required\n\n- `name` (String)\n\n### Read-Only\n\n- `id` (String) The ID of this resource.",
+		},
+		{
+			"kind",
+			schemamd.Render,
+			schemamd.Options{
+				Kind: "data source",
+			},
+			"## Schema\n\n### Required\n\n- `name` (String)\n\n### Read-Only\n\n- `id` (String) The ID of this data source.",
+		},
+		{
+			"list resource",
+			schemamd.RenderListResource,
+			schemamd.Options{},
+			"## Schema\n\nThe following arguments are supported in the `config` block of the `list` block.\n\n### Required\n\n- `name` (String)\n\n### Read-Only\n\n- `id` (String) The ID of this list resource.",
+		},
+		{
+			"description",
+			schemamd.Render,
+			schemamd.Options{
+				IDDescription: "The name of the thing.",
+			},
+			"## Schema\n\n### Required\n\n- `name` (String)\n\n### Read-Only\n\n- `id` (String) The name of the thing.",
+		},
+		{
+			"disabled",
+			schemamd.Render,
+			schemamd.Options{
+				DisableIDDefault: true,
+			},
+			"## Schema\n\n### Required\n\n- `name` (String)\n\n### Optional\n\n- `id` (String)",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+			err := c.render(schema, b, c.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := strings.TrimRight(b.String(), "\n")
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestRenderIdentitySchema(t *testing.T) {
 	t.Parallel()
 
@@ -134,7 +504,7 @@ func TestRenderIdentitySchema(t *testing.T) {
 			}
 
 			b := &strings.Builder{}
-			err = schemamd.RenderIdentitySchema(&identitySchema, b)
+			err = schemamd.RenderIdentitySchema(&identitySchema, b, schemamd.Options{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -187,7 +557,113 @@ func TestRenderAction(t *testing.T) {
 			}
 
 			b := &strings.Builder{}
-			err = schemamd.RenderAction(&schema, b)
+			err = schemamd.RenderAction(&schema, b, schemamd.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Remove \r characters so tests don't fail on windows
+			expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+			// Remove trailing newlines before comparing (some text editors remove them).
+			expectedStr = strings.TrimRight(expectedStr, "\n")
+			actual := strings.TrimRight(b.String(), "\n")
+			if diff := cmp.Diff(expectedStr, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderListResource(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name         string
+		inputFile    string
+		expectedFile string
+	}{
+		{
+			"test_list_resource",
+			"testdata/list-resources/test_list_resource.schema.json",
+			"testdata/list-resources/test_list_resource.md",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(c.inputFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, err := os.ReadFile(c.expectedFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schema tfjson.Schema
+
+			err = json.Unmarshal(input, &schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &strings.Builder{}
+			err = schemamd.RenderListResource(&schema, b, schemamd.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Remove \r characters so tests don't fail on windows
+			expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+			// Remove trailing newlines before comparing (some text editors remove them).
+			expectedStr = strings.TrimRight(expectedStr, "\n")
+			actual := strings.TrimRight(b.String(), "\n")
+			if diff := cmp.Diff(expectedStr, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderStateStore(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name         string
+		inputFile    string
+		expectedFile string
+	}{
+		{
+			"test_state_store",
+			"testdata/state-stores/test_state_store.schema.json",
+			"testdata/state-stores/test_state_store.md",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(c.inputFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, err := os.ReadFile(c.expectedFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schema tfjson.Schema
+
+			err = json.Unmarshal(input, &schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &strings.Builder{}
+			err = schemamd.RenderStateStore(&schema, b, schemamd.Options{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -6,12 +6,22 @@ package schemamd
 import (
 	"fmt"
 	"io"
-	"strings"
 
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 )
 
-func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool) error {
+// dynamicDescription is appended to the description of dynamic attributes, as
+// their type alone does not tell practitioners which values are accepted.
+const dynamicDescription = "This attribute is dynamic, its value can be of any type."
+
+func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool, opts Options) error {
+	return writeAttributeDescription(w, att, includeRW, opts, nil)
+}
+
+// writeAttributeDescription writes the attribute description, followed by the
+// given generated sentences.
+func writeAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool, opts Options, sentences []string) error {
 	_, err := io.WriteString(w, "(")
 	if err != nil {
 		return err
@@ -22,6 +32,11 @@ func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, include
 		return err
 	}
 
+	err = writeTypeConstraintOption(w, att.AttributeType, opts)
+	if err != nil {
+		return err
+	}
+
 	if includeRW {
 		switch {
 		case childAttributeIsRequired(att):
@@ -70,13 +85,9 @@ func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, include
 		return err
 	}
 
-	desc := strings.TrimSpace(att.Description)
-	if desc != "" {
-		_, err = io.WriteString(w, " "+desc)
-		if err != nil {
-			return err
-		}
+	if att.AttributeType == cty.DynamicPseudoType {
+		sentences = append([]string{dynamicDescription}, sentences...)
 	}
 
-	return nil
+	return writeDescription(w, att.Description, att.DescriptionKind, opts, sentences...)
 }
@@ -162,12 +162,72 @@ func TestWriteAttributeDescription(t *testing.T) {
 				Description:   "\n\t This is an attribute.\n\t ",
 			},
 		},
+
+		// dynamic
+		{
+			"(Dynamic, Optional) This is an attribute. This attribute is dynamic, its value can be of any type.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.DynamicPseudoType,
+				Optional:      true,
+				Description:   "This is an attribute.",
+			},
+		},
+		{
+			"(Dynamic, Read-only) This attribute is dynamic, its value can be of any type.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.DynamicPseudoType,
+				Computed:      true,
+			},
+		},
+	} {
+		t.Run(c.expected, func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+			err := schemamd.WriteAttributeDescription(b, c.att, true, schemamd.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := b.String()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteAttributeDescription_TypeConstraints(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		expected string
+		att      *tfjson.SchemaAttribute
+	}{
+		{
+			"(String `string`, Required) This is an attribute.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.String,
+				Required:      true,
+				Description:   "This is an attribute.",
+			},
+		},
+		{
+			"(List of Object `list(object({ name = string }))`, Optional, Sensitive) This is an attribute.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.List(cty.Object(map[string]cty.Type{
+					"name": cty.String,
+				})),
+				Optional:    true,
+				Sensitive:   true,
+				Description: "This is an attribute.",
+			},
+		},
 	} {
 		t.Run(c.expected, func(t *testing.T) {
 			t.Parallel()
 
 			b := &strings.Builder{}
-			err := schemamd.WriteAttributeDescription(b, c.att, true)
+			err := schemamd.WriteAttributeDescription(b, c.att, true, schemamd.Options{TypeConstraints: true})
 			if err != nil {
 				t.Fatal(err)
 			}
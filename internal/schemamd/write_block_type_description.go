@@ -6,12 +6,17 @@ package schemamd
 import (
 	"fmt"
 	"io"
-	"strings"
 
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func WriteBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType) error {
+	return writeBlockTypeDescription(w, block, Options{}, nil)
+}
+
+// writeBlockTypeDescription writes the block description, followed by the
+// given generated sentences.
+func writeBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType, opts Options, sentences []string) error {
 	_, err := io.WriteString(w, "(Block")
 	if err != nil {
 		return err
@@ -87,13 +92,5 @@ func WriteBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType) error
 		return err
 	}
 
-	desc := strings.TrimSpace(block.Block.Description)
-	if desc != "" {
-		_, err = io.WriteString(w, " "+desc)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return writeDescription(w, block.Block.Description, block.Block.DescriptionKind, opts, sentences...)
 }
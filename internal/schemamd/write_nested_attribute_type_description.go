@@ -6,12 +6,17 @@ package schemamd
 import (
 	"fmt"
 	"io"
-	"strings"
 
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
-func WriteNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool) error {
+func WriteNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool, opts Options) error {
+	return writeNestedAttributeTypeDescription(w, att, includeRW, opts, nil)
+}
+
+// writeNestedAttributeTypeDescription writes the nested attribute description,
+// followed by the given generated sentences.
+func writeNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool, opts Options, sentences []string) error {
 	nestedAttributeType := att.AttributeNestedType
 	if nestedAttributeType == nil {
 		return fmt.Errorf("AttributeNestedType is nil")
@@ -45,6 +50,18 @@ func WriteNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribut
 		}
 	}
 
+	if opts.TypeConstraints {
+		ty, err := attributesType(nestedAttributeType)
+		if err != nil {
+			return err
+		}
+
+		err = writeTypeConstraintOption(w, ty, opts)
+		if err != nil {
+			return err
+		}
+	}
+
 	if nestingMode == tfjson.SchemaNestingModeSingle {
 		if includeRW {
 			switch {
@@ -109,13 +126,5 @@ func WriteNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribut
 		return err
 	}
 
-	desc := strings.TrimSpace(att.Description)
-	if desc != "" {
-		_, err = io.WriteString(w, " "+desc)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return writeDescription(w, att.Description, att.DescriptionKind, opts, sentences...)
 }
@@ -113,7 +113,7 @@ func TestWriteNestedAttributeTypeDescription(t *testing.T) {
 			t.Parallel()
 
 			b := &strings.Builder{}
-			err := schemamd.WriteNestedAttributeTypeDescription(b, c.att, true)
+			err := schemamd.WriteNestedAttributeTypeDescription(b, c.att, true, schemamd.Options{})
 			if err != nil {
 				t.Fatal(err)
 			}
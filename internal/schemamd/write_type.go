@@ -54,8 +54,23 @@ func WriteType(w io.Writer, ty cty.Type) error {
 		}
 		return nil
 	case ty.IsTupleType():
-		// TODO: write additional type info?
-		_, err := io.WriteString(w, "Tuple")
+		_, err := io.WriteString(w, "Tuple of [")
+		if err != nil {
+			return err
+		}
+		for i, elem := range ty.TupleElementTypes() {
+			if i > 0 {
+				_, err = io.WriteString(w, ", ")
+				if err != nil {
+					return err
+				}
+			}
+			err = WriteType(w, elem)
+			if err != nil {
+				return fmt.Errorf("unable to write element type %d for %q: %w", i, ty.FriendlyName(), err)
+			}
+		}
+		_, err = io.WriteString(w, "]")
 		return err
 	case ty.IsObjectType():
 		_, err := io.WriteString(w, "Object")
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// WriteTypeConstraint writes the Terraform type constraint syntax of the given
+// type (e.g. "list(object({ name = string }))") to the specified writer.
+func WriteTypeConstraint(w io.Writer, ty cty.Type) error {
+	switch {
+	case ty == cty.DynamicPseudoType:
+		_, err := io.WriteString(w, "any")
+		return err
+	case ty.IsPrimitiveType():
+		switch ty {
+		case cty.String:
+			_, err := io.WriteString(w, "string")
+			return err
+		case cty.Bool:
+			_, err := io.WriteString(w, "bool")
+			return err
+		case cty.Number:
+			_, err := io.WriteString(w, "number")
+			return err
+		}
+		return fmt.Errorf("unexpected primitive type %q", ty.FriendlyName())
+	case ty.IsCollectionType():
+		switch {
+		default:
+			return fmt.Errorf("unexpected collection type %q", ty.FriendlyName())
+		case ty.IsListType():
+			_, err := io.WriteString(w, "list(")
+			if err != nil {
+				return err
+			}
+		case ty.IsSetType():
+			_, err := io.WriteString(w, "set(")
+			if err != nil {
+				return err
+			}
+		case ty.IsMapType():
+			_, err := io.WriteString(w, "map(")
+			if err != nil {
+				return err
+			}
+		}
+		err := WriteTypeConstraint(w, ty.ElementType())
+		if err != nil {
+			return fmt.Errorf("unable to write element type constraint for %q: %w", ty.FriendlyName(), err)
+		}
+		_, err = io.WriteString(w, ")")
+		return err
+	case ty.IsTupleType():
+		_, err := io.WriteString(w, "tuple([")
+		if err != nil {
+			return err
+		}
+		for i, elem := range ty.TupleElementTypes() {
+			if i > 0 {
+				_, err = io.WriteString(w, ", ")
+				if err != nil {
+					return err
+				}
+			}
+			err = WriteTypeConstraint(w, elem)
+			if err != nil {
+				return fmt.Errorf("unable to write element type constraint %d for %q: %w", i, ty.FriendlyName(), err)
+			}
+		}
+		_, err = io.WriteString(w, "])")
+		return err
+	case ty.IsObjectType():
+		atts := ty.AttributeTypes()
+		if len(atts) == 0 {
+			_, err := io.WriteString(w, "object({})")
+			return err
+		}
+
+		names := make([]string, 0, len(atts))
+		for n := range atts {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		_, err := io.WriteString(w, "object({ ")
+		if err != nil {
+			return err
+		}
+		for i, name := range names {
+			if i > 0 {
+				_, err = io.WriteString(w, ", ")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = io.WriteString(w, name+" = ")
+			if err != nil {
+				return err
+			}
+			optional := ty.AttributeOptional(name)
+			if optional {
+				_, err = io.WriteString(w, "optional(")
+				if err != nil {
+					return err
+				}
+			}
+			err = WriteTypeConstraint(w, atts[name])
+			if err != nil {
+				return fmt.Errorf("unable to write attribute type constraint %q for %q: %w", name, ty.FriendlyName(), err)
+			}
+			if optional {
+				_, err = io.WriteString(w, ")")
+				if err != nil {
+					return err
+				}
+			}
+		}
+		_, err = io.WriteString(w, " })")
+		return err
+	}
+	return fmt.Errorf("unexpected type %q", ty.FriendlyName())
+}
+
+// attributesType returns the type of the given nested attribute type, as
+// it can be written as a type constraint. Optional and computed nested
+// attributes are optional object attributes.
+func attributesType(nested *tfjson.SchemaNestedAttributeType) (cty.Type, error) {
+	atts := make(map[string]cty.Type, len(nested.Attributes))
+	optional := []string{}
+
+	for name, att := range nested.Attributes {
+		ty := att.AttributeType
+		if att.AttributeNestedType != nil {
+			var err error
+			ty, err = attributesType(att.AttributeNestedType)
+			if err != nil {
+				return cty.NilType, fmt.Errorf("unable to determine type of attribute %q: %w", name, err)
+			}
+		}
+
+		atts[name] = ty
+		if !att.Required {
+			optional = append(optional, name)
+		}
+	}
+
+	ty := cty.ObjectWithOptionalAttrs(atts, optional)
+
+	switch nested.NestingMode {
+	case tfjson.SchemaNestingModeSingle:
+		return ty, nil
+	case tfjson.SchemaNestingModeList:
+		return cty.List(ty), nil
+	case tfjson.SchemaNestingModeSet:
+		return cty.Set(ty), nil
+	case tfjson.SchemaNestingModeMap:
+		return cty.Map(ty), nil
+	}
+
+	return cty.NilType, fmt.Errorf("unexpected nesting mode for attributes: %s", nested.NestingMode)
+}
+
+// writeTypeConstraintOption writes the type constraint syntax of the given type
+// as a code span after the friendly type name, if enabled in the options.
+func writeTypeConstraintOption(w io.Writer, ty cty.Type, opts Options) error {
+	if !opts.TypeConstraints {
+		return nil
+	}
+
+	_, err := io.WriteString(w, " `")
+	if err != nil {
+		return err
+	}
+
+	err = WriteTypeConstraint(w, ty)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "`")
+	return err
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+)
+
+func TestWriteTypeConstraint(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		expected string
+		ty       cty.Type
+	}{
+		{"bool", cty.Bool},
+		{"any", cty.DynamicPseudoType},
+		{"number", cty.Number},
+		{"string", cty.String},
+
+		{"list(bool)", cty.List(cty.Bool)},
+		{"list(any)", cty.List(cty.DynamicPseudoType)},
+
+		{"map(bool)", cty.Map(cty.Bool)},
+
+		{"object({})", cty.EmptyObject},
+		{"object({ bool = bool })", cty.Object(map[string]cty.Type{
+			"bool": cty.Bool,
+		})},
+		{"object({ name = string, port = optional(number) })", cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+			"port": cty.Number,
+			"name": cty.String,
+		}, []string{"port"})},
+
+		{"set(bool)", cty.Set(cty.Bool)},
+
+		{"tuple([])", cty.EmptyTuple},
+		{"tuple([string, number])", cty.Tuple([]cty.Type{cty.String, cty.Number})},
+
+		{"list(object({ name = string }))", cty.List(cty.Object(map[string]cty.Type{
+			"name": cty.String,
+		}))},
+		{"list(map(set(object({ bool = bool }))))", cty.List(cty.Map(cty.Set(cty.Object(map[string]cty.Type{
+			"bool": cty.Bool,
+		}))))},
+	} {
+		t.Run(fmt.Sprintf("%s %s", c.ty.FriendlyName(), c.expected), func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+			err := schemamd.WriteTypeConstraint(b, c.ty)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := b.String()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
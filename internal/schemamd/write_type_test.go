@@ -42,8 +42,13 @@ func TestWriteType(t *testing.T) {
 
 		{"Set of Boolean", cty.Set(cty.Bool)},
 
-		{"Tuple", cty.EmptyTuple},
-		{"Tuple", cty.Tuple([]cty.Type{cty.Bool})},
+		{"Tuple of []", cty.EmptyTuple},
+		{"Tuple of [Boolean]", cty.Tuple([]cty.Type{cty.Bool})},
+		{"Tuple of [String, Number]", cty.Tuple([]cty.Type{cty.String, cty.Number})},
+		{"Tuple of [String, List of Object]", cty.Tuple([]cty.Type{cty.String, cty.List(cty.Object(map[string]cty.Type{
+			"bool": cty.Bool,
+		}))})},
+		{"List of Tuple of [Boolean, Number]", cty.List(cty.Tuple([]cty.Type{cty.Bool, cty.Number}))},
 
 		{"List of Map of Set of Object", cty.List(cty.Map(cty.Set(cty.Object(map[string]cty.Type{
 			"bool": cty.Bool,